@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | config.go
 	::  ::          ::  ::    Created  | 2025-08-07
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -22,6 +22,7 @@ import (
 	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -44,70 +45,115 @@ func New(options ...Option) (*Config, error) {
 
 // LoadFromFile loads configuration from a file with specified options.
 // This method is thread-safe and prevents race conditions during loading.
+// With opts.InjectEnv, a dotenv file sets process environment variables
+// and the configuration is left unchanged.
 func (c *Config) LoadFromFile(filePath string, opts *LoadOptions) error {
 	if opts == nil {
 		opts = &LoadOptions{}
 	}
 
-	// Check if file exists before acquiring lock
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		return fmt.Errorf("%w: %s", ErrFileNotFound, filePath)
+	// Read and parse file outside of lock to minimize lock time
+	configData, err := readConfigFile(filePath, opts)
+	if err != nil {
+		return err
 	}
 
-	// Determine format from file extension if not specified
-	format := opts.Format
-	if format == 0 {
-		ext := strings.ToLower(filepath.Ext(filePath))
-		switch ext {
-		case ".json":
-			format = FormatJSON
-		case ".yaml", ".yml":
-			format = FormatYAML
-		default:
-			format = FormatINI
-		}
+	if opts.InjectEnv && fileFormat(filePath, opts.Format) == FormatDotenv {
+		return injectEnvironment(configData)
 	}
 
-	// Read file outside of lock to minimize lock time
-	// #nosec G304
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return fmt.Errorf("failed to read config file: %w", err)
+	if err := c.checkKeyCollisions(configData); err != nil {
+		return fmt.Errorf("%s: %w", filePath, err)
 	}
 
-	// Parse configuration data outside of lock
-	var configData map[string]any
+	// Now acquire lock and update configuration atomically
+	c.locker().Lock()
+	defer c.locker().Unlock()
 
-	switch format {
-	case FormatJSON:
-		if err := json.Unmarshal(data, &configData); err != nil {
-			return fmt.Errorf("failed to parse JSON config: %w", err)
+	return c.applyLoadedDataUnsafe(configData, opts)
+}
+
+// LoadFromFiles loads configuration from several files, deep-merging each file
+// over the previous ones in order. Later files take precedence, so a shared base
+// file can be followed by per-environment overlays. Nested maps are merged
+// recursively, slices and type conflicts are handled according to opts.MergeStrategy.
+// Defaults, environment overrides and validation are applied once to the merged result.
+// With opts.InjectEnv, dotenv files set process environment variables before the
+// environment overrides are applied instead of being merged.
+func (c *Config) LoadFromFiles(filePaths []string, opts *LoadOptions) error {
+	if opts == nil {
+		opts = &LoadOptions{}
+	}
+
+	if len(filePaths) == 0 {
+		return fmt.Errorf("%w: no files specified", ErrFileNotFound)
+	}
+
+	// Read and parse every file before touching the current configuration
+	merged := make(map[string]any)
+	environment := make(map[string]any)
+
+	for _, filePath := range filePaths {
+		configData, err := readConfigFile(filePath, opts)
+		if err != nil {
+			return err
 		}
-	case FormatYAML:
-		if err := yaml.Unmarshal(data, &configData); err != nil {
-			return fmt.Errorf("failed to parse YAML config: %w", err)
+
+		if opts.InjectEnv && fileFormat(filePath, opts.Format) == FormatDotenv {
+			maps.Copy(environment, configData)
+
+			continue
 		}
-	case FormatINI:
-		// Create a temporary config instance for parsing INI
-		tempConfig := &Config{}
-		configData = tempConfig.parseINI(string(data))
-	default:
-		return fmt.Errorf("%w: unsupported format", ErrInvalidFormat)
+
+		if err := c.checkKeyCollisions(configData); err != nil {
+			return fmt.Errorf("%s: %w", filePath, err)
+		}
+
+		if opts.MergeStrategy.Conflicts == ConflictError {
+			if err := findMergeConflict(merged, configData, "", c.caseInsensitive); err != nil {
+				return fmt.Errorf("failed to merge %s: %w", filePath, err)
+			}
+		}
+
+		mergeMaps(merged, configData, opts.MergeStrategy, c.caseInsensitive)
 	}
 
-	// Now acquire lock and update configuration atomically
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	if err := injectEnvironment(environment); err != nil {
+		return err
+	}
+
+	c.locker().Lock()
+	defer c.locker().Unlock()
+
+	return c.applyLoadedDataUnsafe(merged, opts)
+}
+
+// applyLoadedDataUnsafe replaces the configuration with freshly loaded data and
+// applies defaults, environment overrides and validation from opts.
+// This method assumes the caller holds the write lock.
+func (c *Config) applyLoadedDataUnsafe(configData map[string]any, opts *LoadOptions) error {
+	if configData == nil {
+		configData = make(map[string]any)
+	}
 
 	// Replace existing data
-	c.data = configData
+	c.replaceDataUnsafe(configData)
 
 	// Apply default values only for keys that don't exist
 	c.applyDefaultsUnsafe(opts.DefaultValues)
 
 	// Override with environment variables unless disabled
 	if !opts.IgnoreEnv {
-		c.loadFromEnvironmentUnsafe()
+		var err error
+		if opts.Env != nil {
+			err = c.loadFromMappedEnvironmentUnsafe(opts.Env)
+		} else {
+			err = c.loadFromEnvironmentUnsafe()
+		}
+
+		if err != nil {
+			return err
+		}
 	}
 
 	// Validate required keys
@@ -117,11 +163,8 @@ func (c *Config) LoadFromFile(filePath string, opts *LoadOptions) error {
 
 	// Apply custom validation
 	if opts.ValidationFunc != nil {
-		// Create a copy for validation to avoid exposing internal state
-		dataCopy := make(map[string]any)
-		maps.Copy(dataCopy, c.data)
-
-		if err := opts.ValidationFunc(dataCopy); err != nil {
+		// Validate a deep copy to avoid exposing internal state
+		if err := opts.ValidationFunc(deepCopyMap(c.dataUnsafe())); err != nil {
 			return fmt.Errorf("validation failed: %w", err)
 		}
 	}
@@ -129,6 +172,128 @@ func (c *Config) LoadFromFile(filePath string, opts *LoadOptions) error {
 	return nil
 }
 
+// readConfigFile reads and parses a configuration file.
+// The format is detected from the file extension when opts.Format is not specified.
+func readConfigFile(filePath string, opts *LoadOptions) (map[string]any, error) {
+	// Check if file exists before reading
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: %s", ErrFileNotFound, filePath)
+	}
+
+	// #nosec G304
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	return parseConfigData(data, fileFormat(filePath, opts.Format), opts)
+}
+
+// fileFormat returns format, or the format detected from the file name if format is not specified.
+func fileFormat(filePath string, format Format) Format {
+	if format == 0 {
+		return detectFormat(filePath)
+	}
+
+	return format
+}
+
+// detectFormat determines the configuration format from the file extension.
+// Files named ".env" or ".env.<suffix>" are dotenv files; unknown extensions fall back to INI.
+func detectFormat(filePath string) Format {
+	ext := strings.ToLower(filepath.Ext(filePath))
+	switch ext {
+	case ".json":
+		return FormatJSON
+	case ".jsonc", ".json5":
+		return FormatJSONC
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".toml":
+		return FormatTOML
+	case ".properties":
+		return FormatProperties
+	case ".hcl":
+		return FormatHCL
+	case ".xml":
+		return FormatXML
+	case ".env":
+		return FormatDotenv
+	}
+
+	if strings.HasPrefix(strings.ToLower(filepath.Base(filePath)), ".env.") {
+		return FormatDotenv
+	}
+
+	return FormatINI
+}
+
+// parseConfigData parses raw configuration content in the given format.
+func parseConfigData(data []byte, format Format, opts *LoadOptions) (map[string]any, error) {
+	var configData map[string]any
+
+	switch format {
+	case FormatJSON:
+		if err := json.Unmarshal(data, &configData); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON config: %w", err)
+		}
+	case FormatJSONC:
+		parsed, err := parseJSONC(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JSONC config: %w", err)
+		}
+
+		configData = parsed
+	case FormatYAML:
+		if err := yaml.Unmarshal(data, &configData); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML config: %w", err)
+		}
+	case FormatTOML:
+		parsed, err := parseTOML(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse TOML config: %w", err)
+		}
+
+		configData = parsed
+	case FormatProperties:
+		parsed, err := parseProperties(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse properties config: %w", err)
+		}
+
+		configData = parsed
+	case FormatDotenv:
+		parsed, err := parseDotenv(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse dotenv config: %w", err)
+		}
+
+		configData = parsed
+	case FormatHCL:
+		parsed, err := parseHCL(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse HCL config: %w", err)
+		}
+
+		configData = parsed
+	case FormatXML:
+		parsed, err := parseXML(string(data), opts.XMLAttrPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XML config: %w", err)
+		}
+
+		configData = parsed
+	case FormatINI:
+		// Create a temporary config instance for parsing INI
+		tempConfig := &Config{}
+		configData = tempConfig.parseINI(string(data))
+	default:
+		return nil, fmt.Errorf("%w: unsupported format", ErrInvalidFormat)
+	}
+
+	return configData, nil
+}
+
 // Has checks if a configuration key exists.
 // Supports both flat keys ("key") and nested keys with dot notation ("server.host").
 func (c *Config) Has(key string) bool {
@@ -136,20 +301,12 @@ func (c *Config) Has(key string) bool {
 		return false
 	}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	// Try flat key first
-	if _, exists := c.data[key]; exists {
-		return true
-	}
+	c.locker().RLock()
+	defer c.locker().RUnlock()
 
-	// If flat key doesn't exist and key contains dots, try nested access
-	if strings.Contains(key, ".") {
-		return c.hasNestedKeyUnsafe(key)
-	}
+	_, exists := c.lookupUnsafe(key)
 
-	return false
+	return exists
 }
 
 // Keys returns all configuration keys.
@@ -158,11 +315,13 @@ func (c *Config) Keys() []string {
 		return nil
 	}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	c.locker().RLock()
+	defer c.locker().RUnlock()
 
-	keys := make([]string, 0, len(c.data))
-	for key := range c.data {
+	data := c.dataUnsafe()
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
 		keys = append(keys, key)
 	}
 
@@ -170,19 +329,65 @@ func (c *Config) Keys() []string {
 }
 
 // LoadFromMap loads configuration from a map.
+// Top-level keys replace existing ones; use MergeMap to merge nested maps.
+// Keys that differ only by case are not checked; use LoadFromMapE to report them.
 func (c *Config) LoadFromMap(data map[string]any) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	c.locker().Lock()
+	defer c.locker().Unlock()
+
+	c.loadMapUnsafe(data)
+}
+
+// LoadFromMapE is like LoadFromMap but, with case-insensitive keys, fails with ErrKeyCollision
+// when keys of data differ only by case. The configuration is unchanged on error.
+func (c *Config) LoadFromMapE(data map[string]any) error {
+	if err := c.checkKeyCollisions(data); err != nil {
+		return err
+	}
 
-	maps.Copy(c.data, data)
+	c.locker().Lock()
+	defer c.locker().Unlock()
+
+	c.loadMapUnsafe(data)
+
+	return nil
+}
+
+// loadMapUnsafe replaces top-level keys with deep copies of the values in data.
+// This method assumes the caller holds the write lock.
+func (c *Config) loadMapUnsafe(data map[string]any) {
+	target := c.writableDataUnsafe()
+
+	for key, value := range data {
+		existing, _ := mapKey(target, key, c.caseInsensitive)
+		target[existing] = deepCopy(value)
+	}
+}
+
+// Clone returns an independent deep copy of the configuration with the same options.
+// Cloning a Sub view gives a root configuration holding the view's data.
+func (c *Config) Clone() *Config {
+	if c == nil {
+		return nil
+	}
+
+	c.locker().RLock()
+	defer c.locker().RUnlock()
+
+	return &Config{
+		data:            deepCopyMap(c.dataUnsafe()),
+		keyDelimiter:    c.keyDelimiter,
+		caseInsensitive: c.caseInsensitive,
+		timeLayouts:     slices.Clone(c.timeLayouts),
+	}
 }
 
 // Clear removes all configuration data.
 func (c *Config) Clear() {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	c.locker().Lock()
+	defer c.locker().Unlock()
 
-	c.data = make(map[string]any)
+	c.replaceDataUnsafe(make(map[string]any))
 }
 
 // Size returns the number of configuration keys.
@@ -191,10 +396,10 @@ func (c *Config) Size() int {
 		return 0
 	}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	c.locker().RLock()
+	defer c.locker().RUnlock()
 
-	return len(c.data)
+	return len(c.dataUnsafe())
 }
 
 // IsEmpty returns true if the configuration is empty.
@@ -212,11 +417,11 @@ func (c *Config) String() string {
 		return "Config is nil"
 	}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	c.locker().RLock()
+	defer c.locker().RUnlock()
 
 	var sb strings.Builder
-	for key, value := range c.data {
+	for key, value := range c.dataUnsafe() {
 		sb.WriteString(fmt.Sprintf("%s: %v\n", key, value))
 	}
 
@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | config_test.go
 	::  ::          ::  ::    Created  | 2025-08-07
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -1096,6 +1097,249 @@ func TestConfig_LoadFromFile_ErrorCases(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to parse JSON config")
 }
 
+func TestConfig_LoadFromFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	basePath := filepath.Join(dir, "base.yaml")
+	require.NoError(t, os.WriteFile(basePath, []byte(`
+app_name: base-app
+server:
+  host: 0.0.0.0
+  port: 8080
+  tls:
+    enabled: false
+features:
+  - auth
+  - api
+`), 0o600))
+
+	prodPath := filepath.Join(dir, "prod.json")
+	require.NoError(t, os.WriteFile(prodPath, []byte(`{
+  "server": {"port": 9090, "tls": {"enabled": true}},
+  "features": ["auth"]
+}`), 0o600))
+
+	t.Run("OverlaysInOrder", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		err = c.LoadFromFiles([]string{basePath, prodPath}, &LoadOptions{IgnoreEnv: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, "base-app", c.GetString("app_name"))
+		assert.Equal(t, "0.0.0.0", c.GetString("server.host"))
+		assert.Equal(t, 9090, c.GetInt("server.port"))
+		assert.True(t, c.GetBool("server.tls.enabled"))
+		assert.Equal(t, []string{"auth"}, c.GetStringSlice("features"))
+	})
+
+	t.Run("ReversedOrder", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		err = c.LoadFromFiles([]string{prodPath, basePath}, &LoadOptions{IgnoreEnv: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, 8080, c.GetInt("server.port"))
+		assert.False(t, c.GetBool("server.tls.enabled"))
+	})
+
+	t.Run("ReplacesExistingData", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		c.Set("stale", "value")
+
+		err = c.LoadFromFiles([]string{basePath}, nil)
+		require.NoError(t, err)
+		assert.False(t, c.Has("stale"))
+	})
+
+	t.Run("OptionsAppliedToMergedResult", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		err = c.LoadFromFiles([]string{basePath, prodPath}, &LoadOptions{
+			IgnoreEnv:     true,
+			DefaultValues: map[string]any{"server.timeout": "30s", "server.port": 1},
+			RequiredKeys:  []string{"server.host", "server.tls.enabled"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 30*time.Second, c.GetDuration("server.timeout"))
+		assert.Equal(t, 9090, c.GetInt("server.port"))
+
+		err = c.LoadFromFiles([]string{prodPath}, &LoadOptions{
+			IgnoreEnv:    true,
+			RequiredKeys: []string{"server.host"},
+		})
+		assert.ErrorIs(t, err, ErrRequiredKeyMissing)
+	})
+
+	t.Run("MergeStrategy", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		err = c.LoadFromFiles([]string{basePath, prodPath}, &LoadOptions{
+			IgnoreEnv:     true,
+			MergeStrategy: MergeStrategy{Slices: SliceAppendUnique, Conflicts: ConflictError},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"auth", "api"}, c.GetStringSlice("features"))
+
+		conflictPath := filepath.Join(dir, "conflict.json")
+		require.NoError(t, os.WriteFile(conflictPath, []byte(`{"server": "off"}`), 0o600))
+
+		err = c.LoadFromFiles([]string{basePath, conflictPath}, &LoadOptions{
+			MergeStrategy: MergeStrategy{Conflicts: ConflictError},
+		})
+		assert.ErrorIs(t, err, ErrMergeConflict)
+		assert.Contains(t, err.Error(), "conflict.json")
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		c.Set("kept", "value")
+
+		err = c.LoadFromFiles(nil, nil)
+		assert.ErrorIs(t, err, ErrFileNotFound)
+
+		err = c.LoadFromFiles([]string{basePath, filepath.Join(dir, "missing.yaml")}, nil)
+		assert.ErrorIs(t, err, ErrFileNotFound)
+		assert.Contains(t, err.Error(), "missing.yaml")
+
+		// A failed load must leave the current configuration untouched
+		assert.Equal(t, "value", c.GetString("kept"))
+	})
+}
+
+// TestConfig_DataIsolation tests that data passed in or handed out is never shared
+func TestConfig_DataIsolation(t *testing.T) {
+	t.Run("LoadFromMap", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		source := map[string]any{"server": map[string]any{"port": 8080}, "tags": []string{"a"}}
+		c.LoadFromMap(source)
+
+		source["server"].(map[string]any)["port"] = 9090
+		source["tags"].([]string)[0] = "changed"
+
+		assert.Equal(t, 8080, c.GetInt("server.port"))
+		assert.Equal(t, []string{"a"}, c.GetStringSlice("tags"))
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		features := []any{"auth", "api"}
+		c.Set("features", features)
+		features[0] = "changed"
+
+		assert.Equal(t, "auth", c.GetString("features[0]"))
+	})
+
+	t.Run("GetAll", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{"server": map[string]any{"hosts": []any{"a", "b"}}})
+
+		all := c.GetAll()
+		server := all["server"].(map[string]any)
+		server["hosts"].([]any)[0] = "changed"
+		server["port"] = 1
+
+		assert.Equal(t, "a", c.GetString("server.hosts[0]"))
+		assert.False(t, c.Has("server.port"))
+	})
+
+	t.Run("MergeMap appended elements", func(t *testing.T) {
+		for _, mode := range []SliceMergeMode{SliceAppend, SliceAppendUnique} {
+			c, err := New()
+			require.NoError(t, err)
+
+			c.LoadFromMap(map[string]any{"ups": []any{map[string]any{"host": "a"}}})
+
+			upstream := map[string]any{"host": "b"}
+			require.NoError(t, c.MergeMap(map[string]any{"ups": []any{upstream}}, MergeStrategy{Slices: mode}))
+
+			upstream["host"] = "changed"
+
+			assert.Equal(t, "b", c.GetString("ups[1].host"))
+		}
+	})
+
+	t.Run("ValidationFunc", func(t *testing.T) {
+		dir := t.TempDir()
+		configPath := filepath.Join(dir, "config.json")
+		require.NoError(t, os.WriteFile(configPath, []byte(`{"server": {"port": 8080, "hosts": ["a"]}}`), 0o600))
+
+		c, err := New()
+		require.NoError(t, err)
+
+		err = c.LoadFromFile(configPath, &LoadOptions{
+			IgnoreEnv: true,
+			ValidationFunc: func(data map[string]any) error {
+				server := data["server"].(map[string]any)
+				server["port"] = 1
+				server["hosts"].([]any)[0] = "changed"
+
+				return nil
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 8080, c.GetInt("server.port"))
+		assert.Equal(t, "a", c.GetString("server.hosts[0]"))
+	})
+}
+
+// TestConfig_Clone tests independent copies of a configuration
+func TestConfig_Clone(t *testing.T) {
+	c, err := New(WithKeyDelimiter("/"), WithCaseInsensitiveKeys(), WithTimeLayouts("02.01.2006"))
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"server":  map[string]any{"port": 8080, "hosts": []any{"a", "b"}},
+		"release": "16.10.2026",
+	})
+
+	clone := c.Clone()
+	require.NotNil(t, clone)
+	assert.Equal(t, c.GetAll(), clone.GetAll())
+
+	// Options are kept
+	assert.Equal(t, 8080, clone.GetInt("SERVER/PORT"))
+	assert.Equal(t, 2026, clone.GetTime("release").Year())
+
+	// Changes on either side stay on that side
+	clone.Set("server/port", 9090)
+	clone.Set("server/hosts[0]", "changed")
+	c.Set("server/extra", true)
+
+	assert.Equal(t, 8080, c.GetInt("server/port"))
+	assert.Equal(t, "a", c.GetString("server/hosts[0]"))
+	assert.False(t, clone.Has("server/extra"))
+
+	t.Run("Sub view", func(t *testing.T) {
+		server := c.Sub("server").Clone()
+		require.NotNil(t, server)
+
+		assert.Equal(t, 8080, server.GetInt("port"))
+
+		server.Set("port", 1)
+		assert.Equal(t, 8080, c.GetInt("server/port"))
+	})
+
+	t.Run("nil config", func(t *testing.T) {
+		var nilConfig *Config
+		assert.Nil(t, nilConfig.Clone())
+	})
+}
+
 // Benchmark Tests for config.go functions
 
 func BenchmarkNew(b *testing.B) {
@@ -1273,6 +1517,6 @@ func BenchmarkConfig_String(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		c.String()
+		_ = c.String()
 	}
 }
@@ -0,0 +1,631 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | convert.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// toString converts a configuration value to a string.
+// Non-string values are formatted with fmt.
+func toString(value any) (string, error) {
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+
+	return fmt.Sprintf("%v", value), nil
+}
+
+// toInt converts a configuration value to an int.
+func toInt(value any) (int, error) {
+	parsed, err := toInt64(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if parsed < math.MinInt || parsed > math.MaxInt {
+		return 0, fmt.Errorf("value %d overflows int", parsed)
+	}
+
+	return int(parsed), nil
+}
+
+// toInt32 converts a configuration value to an int32.
+func toInt32(value any) (int32, error) {
+	parsed, err := toInt64(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if parsed < math.MinInt32 || parsed > math.MaxInt32 {
+		return 0, fmt.Errorf("value %d overflows int32", parsed)
+	}
+
+	return int32(parsed), nil
+}
+
+// toInt64 converts a configuration value to an int64.
+// Floats are truncated, strings hold integer literals (see parseIntLiteral).
+func toInt64(value any) (int64, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint:
+		return uintToInt64(uint64(v))
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		return uintToInt64(v)
+	case float32:
+		return floatToInt64(float64(v))
+	case float64:
+		return floatToInt64(v)
+	case json.Number:
+		if parsed, err := parseIntLiteral(string(v)); err == nil {
+			return parsed, nil
+		}
+
+		// Numbers like 1e3 are integers written in exponent form
+		parsed, err := v.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("cannot parse %q as integer", v)
+		}
+
+		return floatToInt64(parsed)
+	case string:
+		return parseIntLiteral(v)
+	default:
+		return 0, errors.New("cannot convert to integer")
+	}
+}
+
+// toUint converts a configuration value to a uint.
+// Negative values are rejected.
+func toUint(value any) (uint, error) {
+	parsed, err := toUint64(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if parsed > math.MaxUint {
+		return 0, fmt.Errorf("value %d overflows uint", parsed)
+	}
+
+	return uint(parsed), nil
+}
+
+// toUint64 converts a configuration value to a uint64.
+// Negative values are rejected.
+func toUint64(value any) (uint64, error) {
+	switch v := value.(type) {
+	case uint:
+		return uint64(v), nil
+	case uint8:
+		return uint64(v), nil
+	case uint16:
+		return uint64(v), nil
+	case uint32:
+		return uint64(v), nil
+	case uint64:
+		return v, nil
+	case float32:
+		return floatToUint64(float64(v))
+	case float64:
+		return floatToUint64(v)
+	case json.Number:
+		if parsed, err := parseUintLiteral(string(v)); err == nil {
+			return parsed, nil
+		}
+
+		// Numbers like 1e3 are integers written in exponent form
+		parsed, err := v.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("cannot parse %q as unsigned integer", v)
+		}
+
+		return floatToUint64(parsed)
+	case string:
+		return parseUintLiteral(v)
+	}
+
+	parsed, err := toInt64(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if parsed < 0 {
+		return 0, fmt.Errorf("negative value %d for unsigned integer", parsed)
+	}
+
+	return uint64(parsed), nil
+}
+
+// toFloat32 converts a configuration value to a float32.
+func toFloat32(value any) (float32, error) {
+	parsed, err := toFloat64(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if !math.IsInf(parsed, 0) && math.Abs(parsed) > math.MaxFloat32 {
+		return 0, fmt.Errorf("value %v overflows float32", parsed)
+	}
+
+	return float32(parsed), nil
+}
+
+// toFloat64 converts a configuration value to a float64.
+func toFloat64(value any) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int8:
+		return float64(v), nil
+	case int16:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint:
+		return float64(v), nil
+	case uint8:
+		return float64(v), nil
+	case uint16:
+		return float64(v), nil
+	case uint32:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case json.Number:
+		parsed, err := v.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("cannot parse %q as float", v)
+		}
+
+		return parsed, nil
+	case string:
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("cannot parse %q as float", v)
+		}
+
+		return parsed, nil
+	default:
+		return 0, errors.New("cannot convert to float")
+	}
+}
+
+// toBool converts a configuration value to a bool.
+func toBool(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("cannot parse %q as bool", v)
+		}
+
+		return parsed, nil
+	default:
+		return false, errors.New("cannot convert to bool")
+	}
+}
+
+// toDuration converts a configuration value to a time.Duration.
+// Strings like "30s" are parsed, numbers are interpreted as seconds.
+func toDuration(value any) (time.Duration, error) {
+	switch v := value.(type) {
+	case time.Duration:
+		return v, nil
+	case string:
+		if parsed, err := time.ParseDuration(v); err == nil {
+			return parsed, nil
+		}
+
+		if seconds, err := strconv.Atoi(v); err == nil {
+			return time.Duration(seconds) * time.Second, nil
+		}
+
+		return 0, fmt.Errorf("cannot parse %q as duration", v)
+	case int:
+		return time.Duration(v) * time.Second, nil
+	case int64:
+		return time.Duration(v) * time.Second, nil
+	case float64:
+		return time.Duration(v * float64(time.Second)), nil
+	default:
+		return 0, errors.New("cannot convert to duration")
+	}
+}
+
+// toStringSlice converts a configuration value to a string slice.
+// Lists are formatted element by element, strings are split like splitList except
+// that an empty string is kept as a single empty element.
+func toStringSlice(value any) ([]string, error) {
+	switch v := value.(type) {
+	case []string:
+		return append([]string{}, v...), nil
+	case []any:
+		result := make([]string, len(v))
+		for i, item := range v {
+			result[i] = fmt.Sprintf("%v", item)
+		}
+
+		return result, nil
+	case string:
+		if v == "" {
+			return []string{""}, nil
+		}
+
+		return toSliceOf(v, toString)
+	default:
+		return nil, errors.New("cannot convert to string slice")
+	}
+}
+
+// toSliceOf converts a list to a slice of T, converting each element with convert.
+// Any slice or array type is accepted; strings are split on commas with surrounding spaces trimmed.
+// Errors name the index of the first element that fails to convert.
+func toSliceOf[T any](value any, convert func(any) (T, error)) ([]T, error) {
+	var items []any
+
+	if str, ok := value.(string); ok {
+		items = splitList(str)
+	} else {
+		list := reflect.ValueOf(value)
+		if list.Kind() != reflect.Slice && list.Kind() != reflect.Array {
+			return nil, errors.New("cannot convert to slice")
+		}
+
+		items = make([]any, list.Len())
+		for i := range items {
+			items[i] = list.Index(i).Interface()
+		}
+	}
+
+	result := make([]T, len(items))
+
+	for i, item := range items {
+		converted, err := convert(item)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+
+		result[i] = converted
+	}
+
+	return result, nil
+}
+
+// splitList splits a comma-separated list, trimming spaces around each element.
+// An empty string is an empty list.
+func splitList(str string) []any {
+	if str == "" {
+		return []any{}
+	}
+
+	parts := strings.Split(str, ",")
+
+	items := make([]any, len(parts))
+	for i, part := range parts {
+		items[i] = strings.TrimSpace(part)
+	}
+
+	return items
+}
+
+// toIntSlice converts a configuration value to an int slice.
+func toIntSlice(value any) ([]int, error) {
+	return toSliceOf(value, toInt)
+}
+
+// toFloat64Slice converts a configuration value to a float64 slice.
+func toFloat64Slice(value any) ([]float64, error) {
+	return toSliceOf(value, toFloat64)
+}
+
+// toBoolSlice converts a configuration value to a bool slice.
+func toBoolSlice(value any) ([]bool, error) {
+	return toSliceOf(value, toBool)
+}
+
+// toDurationSlice converts a configuration value to a duration slice.
+func toDurationSlice(value any) ([]time.Duration, error) {
+	return toSliceOf(value, toDuration)
+}
+
+// toMapOf converts a map with string-like keys to a map[string]T, converting each value with convert.
+// Errors name the key of the first value that fails to convert.
+func toMapOf[T any](value any, convert func(any) (T, error)) (map[string]T, error) {
+	source := reflect.ValueOf(value)
+	if source.Kind() != reflect.Map {
+		return nil, errors.New("cannot convert to map")
+	}
+
+	result := make(map[string]T, source.Len())
+	iter := source.MapRange()
+
+	for iter.Next() {
+		// String keys are kept as is, other keys of map[any]any are formatted
+		key := fmt.Sprint(iter.Key().Interface())
+
+		converted, err := convert(iter.Value().Interface())
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", key, err)
+		}
+
+		result[key] = converted
+	}
+
+	return result, nil
+}
+
+// toStringMap converts a configuration value to a map[string]any.
+func toStringMap(value any) (map[string]any, error) {
+	return toMapOf(value, func(item any) (any, error) { return deepCopy(item), nil })
+}
+
+// toStringMapString converts a configuration value to a map[string]string.
+// Values that are maps or lists cannot be represented as a single string and are rejected.
+func toStringMapString(value any) (map[string]string, error) {
+	return toMapOf(value, toScalarString)
+}
+
+// toScalarString converts a configuration value to a string, rejecting maps and slices
+// whose formatted form is not a meaningful string value.
+func toScalarString(value any) (string, error) {
+	if kind := reflect.ValueOf(value).Kind(); kind == reflect.Map || kind == reflect.Slice {
+		return "", errors.New("cannot convert to string")
+	}
+
+	return toString(value)
+}
+
+// toStringMapStringSlice converts a configuration value to a map[string][]string.
+// Scalar string values become single-element slices after comma splitting.
+func toStringMapStringSlice(value any) (map[string][]string, error) {
+	return toMapOf(value, toStringSlice)
+}
+
+// byteSizeUnits maps size units to their multiplier: SI units are powers of 1000, IEC units powers of 1024.
+// Units are matched case-insensitively, the "B" suffix is optional.
+var byteSizeUnits = map[string]uint64{
+	"":   1,
+	"b":  1,
+	"k":  1e3,
+	"kb": 1e3,
+	"m":  1e6,
+	"mb": 1e6,
+	"g":  1e9,
+	"gb": 1e9,
+	"t":  1e12,
+	"tb": 1e12,
+	"p":  1e15,
+	"pb": 1e15,
+	"e":  1e18,
+	"eb": 1e18,
+
+	"ki":  1 << 10,
+	"kib": 1 << 10,
+	"mi":  1 << 20,
+	"mib": 1 << 20,
+	"gi":  1 << 30,
+	"gib": 1 << 30,
+	"ti":  1 << 40,
+	"tib": 1 << 40,
+	"pi":  1 << 50,
+	"pib": 1 << 50,
+	"ei":  1 << 60,
+	"eib": 1 << 60,
+}
+
+// toByteSize converts a configuration value to a number of bytes.
+// Strings may carry an SI ("10MB", "1.5G") or IEC ("512KiB") unit,
+// other values are converted like unsigned integers.
+func toByteSize(value any) (uint64, error) {
+	str, ok := value.(string)
+	if !ok {
+		return toUint64(value)
+	}
+
+	trimmed := strings.TrimSpace(str)
+
+	// Split the number from the unit
+	unitStart := strings.IndexFunc(trimmed, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.' && r != '_' && r != '+' && r != '-'
+	})
+	if unitStart < 0 {
+		unitStart = len(trimmed)
+	}
+
+	number := trimmed[:unitStart]
+	unit := strings.ToLower(strings.TrimSpace(trimmed[unitStart:]))
+
+	multiplier, known := byteSizeUnits[unit]
+	if number == "" || !known {
+		// Allow plain integer literals such as "0x400"
+		if parsed, err := parseUintLiteral(trimmed); err == nil {
+			return parsed, nil
+		}
+
+		return 0, fmt.Errorf("cannot parse %q as byte size", str)
+	}
+
+	if parsed, err := parseUintLiteral(number); err == nil {
+		if parsed > math.MaxUint64/multiplier {
+			return 0, fmt.Errorf("byte size %q overflows uint64", str)
+		}
+
+		return parsed * multiplier, nil
+	}
+
+	parsed, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse %q as byte size", str)
+	}
+
+	if parsed < 0 {
+		return 0, fmt.Errorf("negative byte size %q", str)
+	}
+
+	size := parsed * float64(multiplier)
+	if size >= math.MaxUint64 {
+		return 0, fmt.Errorf("byte size %q overflows uint64", str)
+	}
+
+	return uint64(size), nil
+}
+
+// typeMismatchError describes a value at key that could not be converted.
+func typeMismatchError(key string, value any, err error) error {
+	if key == "" {
+		return fmt.Errorf("%w: %T value: %w", ErrTypeMismatch, value, err)
+	}
+
+	return fmt.Errorf("%w: %q (%T value): %w", ErrTypeMismatch, key, value, err)
+}
+
+// parseIntLiteral parses a decimal integer, or a Go-style literal with a 0x, 0o or 0b prefix.
+// Underscores may separate digits ("1_000_000"); a leading zero does not mean octal.
+func parseIntLiteral(literal string) (int64, error) {
+	digits, base, ok := splitIntLiteral(literal)
+	if !ok {
+		return 0, fmt.Errorf("cannot parse %q as integer", literal)
+	}
+
+	parsed, err := strconv.ParseInt(digits, base, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("value %s overflows int64", literal)
+	} else if err != nil {
+		return 0, fmt.Errorf("cannot parse %q as integer", literal)
+	}
+
+	return parsed, nil
+}
+
+// parseUintLiteral parses an unsigned integer literal, see parseIntLiteral.
+func parseUintLiteral(literal string) (uint64, error) {
+	digits, base, ok := splitIntLiteral(literal)
+	if !ok {
+		return 0, fmt.Errorf("cannot parse %q as unsigned integer", literal)
+	}
+
+	if strings.HasPrefix(digits, "-") {
+		if parsed, err := strconv.ParseInt(digits, base, 64); err == nil && parsed == 0 {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf("negative value %s for unsigned integer", literal)
+	}
+
+	parsed, err := strconv.ParseUint(strings.TrimPrefix(digits, "+"), base, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("value %s overflows uint64", literal)
+	} else if err != nil {
+		return 0, fmt.Errorf("cannot parse %q as unsigned integer", literal)
+	}
+
+	return parsed, nil
+}
+
+// splitIntLiteral removes digit separators and the radix prefix from an integer literal,
+// returning the signed digits and their base. Returns false if underscores are misplaced.
+func splitIntLiteral(literal string) (string, int, bool) {
+	sign, digits := "", literal
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		sign, digits = digits[:1], digits[1:]
+	}
+
+	base := 10
+
+	if len(digits) > 2 && digits[0] == '0' {
+		switch digits[1] {
+		case 'x', 'X':
+			base = 16
+		case 'o', 'O':
+			base = 8
+		case 'b', 'B':
+			base = 2
+		}
+
+		if base != 10 {
+			digits = strings.TrimPrefix(digits[2:], "_")
+		}
+	}
+
+	if strings.HasPrefix(digits, "_") || strings.HasSuffix(digits, "_") || strings.Contains(digits, "__") {
+		return "", 0, false
+	}
+
+	return sign + strings.ReplaceAll(digits, "_", ""), base, true
+}
+
+// uintToInt64 converts an unsigned value to int64, reporting overflow.
+func uintToInt64(value uint64) (int64, error) {
+	if value > math.MaxInt64 {
+		return 0, fmt.Errorf("value %d overflows int64", value)
+	}
+
+	return int64(value), nil
+}
+
+// floatToInt64 truncates a float to int64, reporting values out of range.
+func floatToInt64(value float64) (int64, error) {
+	if math.IsNaN(value) || value < math.MinInt64 || value >= math.MaxInt64 {
+		return 0, fmt.Errorf("value %v overflows int64", value)
+	}
+
+	return int64(value), nil
+}
+
+// floatToUint64 truncates a float to uint64, reporting negative values and values out of range.
+func floatToUint64(value float64) (uint64, error) {
+	if value < 0 {
+		return 0, fmt.Errorf("negative value %v for unsigned integer", value)
+	}
+
+	if math.IsNaN(value) || value >= math.MaxUint64 {
+		return 0, fmt.Errorf("value %v overflows uint64", value)
+	}
+
+	return uint64(value), nil
+}
@@ -0,0 +1,290 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | convert_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseIntLiteral tests parsing of integer literals
+func TestParseIntLiteral(t *testing.T) {
+	valid := map[string]int64{
+		"42":                   42,
+		"-42":                  -42,
+		"+7":                   7,
+		"017":                  17,
+		"1_000_000":            1000000,
+		"0x1F":                 31,
+		"0XfF":                 255,
+		"-0x10":                -16,
+		"0o17":                 15,
+		"0b1010":               10,
+		"0x_ff":                255,
+		"9223372036854775807":  math.MaxInt64,
+		"-9223372036854775808": math.MinInt64,
+	}
+
+	for literal, expected := range valid {
+		parsed, err := parseIntLiteral(literal)
+		require.NoError(t, err, literal)
+		assert.Equal(t, expected, parsed, literal)
+	}
+
+	for _, literal := range []string{"", "abc", "1.5", "_1", "1_", "1__0", "0x", "0b2", " 1"} {
+		_, err := parseIntLiteral(literal)
+		assert.Error(t, err, literal)
+	}
+
+	_, err := parseIntLiteral("9223372036854775808")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "overflows int64")
+}
+
+// TestParseUintLiteral tests parsing of unsigned integer literals
+func TestParseUintLiteral(t *testing.T) {
+	parsed, err := parseUintLiteral("18446744073709551615")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), parsed)
+
+	parsed, err = parseUintLiteral("0xFFFF_FFFF")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint32), parsed)
+
+	parsed, err = parseUintLiteral("-0")
+	require.NoError(t, err)
+	assert.Zero(t, parsed)
+
+	_, err = parseUintLiteral("-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "negative")
+
+	_, err = parseUintLiteral("18446744073709551616")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "overflows uint64")
+}
+
+// TestNumericConversions tests range checks of the numeric converters
+func TestNumericConversions(t *testing.T) {
+	t.Run("Int64", func(t *testing.T) {
+		for value, expected := range map[any]int64{
+			int8(-8):             -8,
+			uint32(32):           32,
+			uint64(64):           64,
+			3.9:                  3,
+			float32(-2.5):        -2,
+			json.Number("12"):    12,
+			json.Number("1e3"):   1000,
+			json.Number("0x10"):  16,
+			"1_024":              1024,
+			uint(math.MaxUint32): math.MaxUint32,
+		} {
+			parsed, err := toInt64(value)
+			require.NoError(t, err, value)
+			assert.Equal(t, expected, parsed, value)
+		}
+
+		for _, value := range []any{uint64(math.MaxUint64), 1e20, math.NaN(), json.Number("x"), true, nil} {
+			_, err := toInt64(value)
+			assert.Error(t, err, value)
+		}
+	})
+
+	t.Run("Int32", func(t *testing.T) {
+		parsed, err := toInt32(int64(math.MaxInt32))
+		require.NoError(t, err)
+		assert.Equal(t, int32(math.MaxInt32), parsed)
+
+		_, err = toInt32(int64(math.MaxInt32) + 1)
+		assert.ErrorContains(t, err, "overflows int32")
+
+		_, err = toInt32("-2147483649")
+		assert.ErrorContains(t, err, "overflows int32")
+	})
+
+	t.Run("Uint64", func(t *testing.T) {
+		for value, expected := range map[any]uint64{
+			uint64(math.MaxUint64): math.MaxUint64,
+			42:                     42,
+			int64(7):               7,
+			1e19:                   1e19,
+			json.Number("99"):      99,
+			json.Number("1e3"):     1000,
+			"0b11":                 3,
+		} {
+			parsed, err := toUint64(value)
+			require.NoError(t, err, value)
+			assert.Equal(t, expected, parsed, value)
+		}
+
+		for _, value := range []any{
+			-1, int64(-5), -0.5, "-3", 1e20, "abc", false, json.Number("-1e3"), json.Number("1e20"), json.Number("x"),
+		} {
+			_, err := toUint64(value)
+			assert.Error(t, err, value)
+		}
+	})
+
+	t.Run("Float32", func(t *testing.T) {
+		parsed, err := toFloat32("1.5")
+		require.NoError(t, err)
+		assert.Equal(t, float32(1.5), parsed)
+
+		parsed, err = toFloat32(json.Number("2.25"))
+		require.NoError(t, err)
+		assert.Equal(t, float32(2.25), parsed)
+
+		_, err = toFloat32(1e39)
+		assert.ErrorContains(t, err, "overflows float32")
+
+		parsed, err = toFloat32(math.Inf(1))
+		require.NoError(t, err)
+		assert.True(t, math.IsInf(float64(parsed), 1))
+	})
+
+	t.Run("Float64", func(t *testing.T) {
+		for value, expected := range map[any]float64{
+			int8(1): 1, uint16(2): 2, uint64(3): 3, "1_000.5": 1000.5, json.Number("0.5"): 0.5,
+		} {
+			parsed, err := toFloat64(value)
+			require.NoError(t, err, value)
+			assert.Equal(t, expected, parsed, value)
+		}
+	})
+}
+
+// TestToByteSize tests parsing of human-readable byte sizes
+func TestToByteSize(t *testing.T) {
+	valid := map[any]uint64{
+		"512":           512,
+		"10B":           10,
+		"1k":            1000,
+		"10MB":          10_000_000,
+		"10 MB":         10_000_000,
+		"1.5G":          1_500_000_000,
+		"2tb":           2_000_000_000_000,
+		"512KiB":        512 << 10,
+		"1Ki":           1024,
+		"1.5MiB":        1_572_864,
+		"4GiB":          4 << 30,
+		"1_024 kib":     1 << 20,
+		"0x400":         1024,
+		"15EiB":         15 << 60,
+		1048576:         1048576,
+		int64(42):       42,
+		uint64(1 << 40): 1 << 40,
+		2.0:             2,
+	}
+
+	for value, expected := range valid {
+		parsed, err := toByteSize(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, parsed, value)
+	}
+
+	for _, value := range []any{"", "MB", "10XB", "ten MB", "-1KB", "-5", "16EiB", "1.2.3MB", -1, true} {
+		_, err := toByteSize(value)
+		assert.Error(t, err, value)
+	}
+}
+
+// TestToSliceOf tests typed slice conversion
+func TestToSliceOf(t *testing.T) {
+	t.Run("accepts any slice type", func(t *testing.T) {
+		for _, value := range []any{[]any{1, "2", 3.0}, []int{1, 2, 3}, []string{"1", "2", "3"}, [3]int64{1, 2, 3}} {
+			converted, err := toIntSlice(value)
+			require.NoError(t, err, value)
+			assert.Equal(t, []int{1, 2, 3}, converted, value)
+		}
+	})
+
+	t.Run("splits comma-separated strings", func(t *testing.T) {
+		converted, err := toDurationSlice("1s, 2m ,3h")
+		require.NoError(t, err)
+		assert.Equal(t, []time.Duration{time.Second, 2 * time.Minute, 3 * time.Hour}, converted)
+
+		empty, err := toBoolSlice("")
+		require.NoError(t, err)
+		assert.Empty(t, empty)
+	})
+
+	t.Run("reports bad element index", func(t *testing.T) {
+		_, err := toFloat64Slice([]any{1.5, 2, "heavy"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "element 2")
+
+		_, err = toIntSlice("1,2,x")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "element 2")
+	})
+
+	t.Run("rejects non-lists", func(t *testing.T) {
+		for _, value := range []any{42, true, map[string]any{"a": 1}, nil} {
+			_, err := toIntSlice(value)
+			assert.Error(t, err, value)
+		}
+	})
+}
+
+// TestToMapOf tests typed map conversion
+func TestToMapOf(t *testing.T) {
+	t.Run("string map", func(t *testing.T) {
+		converted, err := toStringMap(map[string]any{"a": 1, "b": []any{"x"}})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"a": 1, "b": []any{"x"}}, converted)
+
+		converted, err = toStringMap(map[any]any{"a": 1, 2: "two"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"a": 1, "2": "two"}, converted)
+
+		_, err = toStringMap([]any{1})
+		assert.Error(t, err)
+	})
+
+	t.Run("string map of strings", func(t *testing.T) {
+		converted, err := toStringMapString(map[string]any{"port": 8080, "debug": true, "name": "api"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"port": "8080", "debug": "true", "name": "api"}, converted)
+
+		_, err = toStringMapString(map[string]any{"nested": map[string]any{"a": 1}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `key "nested"`)
+	})
+
+	t.Run("string map of string slices", func(t *testing.T) {
+		converted, err := toStringMapStringSlice(map[string]any{
+			"admins": []any{"alice", "bob"},
+			"users":  "carol,dave",
+			"guests": []string{},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]string{
+			"admins": {"alice", "bob"},
+			"users":  {"carol", "dave"},
+			"guests": {},
+		}, converted)
+
+		_, err = toStringMapStringSlice(map[string]any{"count": 3})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `key "count"`)
+	})
+}
@@ -0,0 +1,223 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | dotenv_parser.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// dotenvNamePattern matches valid variable names in dotenv files.
+var dotenvNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// parseDotenv parses dotenv content into flat string values keyed by variable name.
+// Lines may start with "export". Single-quoted values are literal, double-quoted values
+// may span lines and support \n, \r, \t, \", \\ and \$ escapes, and unquoted values end
+// at a " #" comment. ${VAR} in double-quoted and unquoted values is replaced with a variable
+// defined earlier in the file or, failing that, with the process environment.
+func parseDotenv(content string) (map[string]any, error) {
+	content = strings.TrimPrefix(content, "\ufeff")
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	lines := strings.Split(content, "\n")
+	values := make(map[string]string)
+
+	lookup := func(name string) string {
+		if value, ok := values[name]; ok {
+			return value
+		}
+
+		return os.Getenv(name)
+	}
+
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimSpace(lines[i])
+
+		// Skip empty lines and comments
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if rest, found := strings.CutPrefix(line, "export"); found && rest != "" && (rest[0] == ' ' || rest[0] == '\t') {
+			line = strings.TrimSpace(rest)
+		}
+
+		name, raw, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("line %d: expected NAME=value", lineNumber)
+		}
+
+		name = strings.TrimSpace(name)
+		if !dotenvNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", lineNumber, name)
+		}
+
+		untrimmed := raw
+		raw = strings.TrimLeft(raw, " \t")
+
+		var (
+			value    string
+			trailing string
+		)
+
+		switch {
+		case strings.HasPrefix(raw, `"`):
+			// Double-quoted values continue on the following lines until the closing quote
+			body := raw[1:]
+			end := closingDotenvQuote(body)
+
+			for end < 0 {
+				if i+1 == len(lines) {
+					return nil, fmt.Errorf("line %d: unterminated double-quoted value", lineNumber)
+				}
+
+				i++
+				body += "\n" + lines[i]
+				end = closingDotenvQuote(body)
+			}
+
+			value = expandDotenv(body[:end], true, lookup)
+			trailing = body[end+1:]
+		case strings.HasPrefix(raw, "'"):
+			end := strings.IndexByte(raw[1:], '\'')
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated single-quoted value", lineNumber)
+			}
+
+			value = raw[1 : end+1]
+			trailing = raw[end+2:]
+		default:
+			if comment := dotenvComment(untrimmed); comment >= 0 {
+				untrimmed = untrimmed[:comment]
+			}
+
+			value = expandDotenv(strings.TrimSpace(untrimmed), false, lookup)
+		}
+
+		if trailing = strings.TrimSpace(trailing); trailing != "" && !strings.HasPrefix(trailing, "#") {
+			return nil, fmt.Errorf("line %d: unexpected %q after quoted value", lineNumber, trailing)
+		}
+
+		// Later definitions replace earlier ones
+		values[name] = value
+	}
+
+	result := make(map[string]any, len(values))
+	for name, value := range values {
+		result[name] = value
+	}
+
+	return result, nil
+}
+
+// dotenvComment returns the index of the first "#" preceded by whitespace in value, or -1,
+// so that "KEY=a#b" keeps the hash.
+func dotenvComment(value string) int {
+	for i := 1; i < len(value); i++ {
+		if value[i] == '#' && (value[i-1] == ' ' || value[i-1] == '\t') {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// closingDotenvQuote returns the index of the first unescaped double quote in body, or -1.
+func closingDotenvQuote(body string) int {
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+
+	return -1
+}
+
+// expandDotenv replaces ${VAR} references using lookup and, if escapes is set,
+// decodes backslash escapes; unknown escapes are kept as written.
+func expandDotenv(value string, escapes bool, lookup func(string) string) string {
+	if !strings.ContainsAny(value, `$\`) {
+		return value
+	}
+
+	var builder strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		switch {
+		case escapes && value[i] == '\\' && i+1 < len(value):
+			i++
+
+			switch value[i] {
+			case 'n':
+				builder.WriteByte('\n')
+			case 'r':
+				builder.WriteByte('\r')
+			case 't':
+				builder.WriteByte('\t')
+			case '"', '\\', '$':
+				builder.WriteByte(value[i])
+			default:
+				builder.WriteByte('\\')
+				builder.WriteByte(value[i])
+			}
+		case strings.HasPrefix(value[i:], "${"):
+			end := strings.IndexByte(value[i:], '}')
+			if end < 0 || !dotenvNamePattern.MatchString(value[i+2:i+end]) {
+				builder.WriteByte(value[i])
+
+				continue
+			}
+
+			builder.WriteString(lookup(value[i+2 : i+end]))
+			i += end
+		default:
+			builder.WriteByte(value[i])
+		}
+	}
+
+	return builder.String()
+}
+
+// injectEnvironment sets a process environment variable for every value that is not
+// already set, so that real environment variables keep precedence over dotenv files.
+func injectEnvironment(values map[string]any) error {
+	var errs []error
+
+	for name, value := range values {
+		if _, set := os.LookupEnv(name); set {
+			continue
+		}
+
+		str, ok := value.(string)
+		if !ok {
+			str = fmt.Sprint(value)
+		}
+
+		if err := os.Setenv(name, str); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set %s: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,258 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | dotenv_parser_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDotenvParser_Comprehensive tests dotenv parsing with extensive coverage
+func TestDotenvParser_Comprehensive(t *testing.T) {
+	t.Run("AssignmentsAndComments", func(t *testing.T) {
+		result, err := parseDotenv(`
+# Database settings
+DB_HOST=localhost
+export DB_PORT=5432
+  SPACED = value with spaces
+EMPTY=
+COMMENTED=value # trailing comment
+HASH=a#b
+ONLY_COMMENT= # nothing here
+export_name=kept
+app.name=billing
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"DB_HOST":      "localhost",
+			"DB_PORT":      "5432",
+			"SPACED":       "value with spaces",
+			"EMPTY":        "",
+			"COMMENTED":    "value",
+			"HASH":         "a#b",
+			"ONLY_COMMENT": "",
+			"export_name":  "kept",
+			"app.name":     "billing",
+		}, result)
+	})
+
+	t.Run("Quoting", func(t *testing.T) {
+		result, err := parseDotenv(`
+SINGLE='literal \n ${HOME} # not a comment'
+DOUBLE="tab\tnew\nline \"quoted\" \\ \$HOME"
+UNKNOWN="keep \q"
+QUOTED_COMMENT="value" # comment
+HASH_IN_QUOTES="a # b"
+PADDED="  spaces kept  "
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, `literal \n ${HOME} # not a comment`, result["SINGLE"])
+		assert.Equal(t, "tab\tnew\nline \"quoted\" \\ $HOME", result["DOUBLE"])
+		assert.Equal(t, `keep \q`, result["UNKNOWN"])
+		assert.Equal(t, "value", result["QUOTED_COMMENT"])
+		assert.Equal(t, "a # b", result["HASH_IN_QUOTES"])
+		assert.Equal(t, "  spaces kept  ", result["PADDED"])
+	})
+
+	t.Run("Multiline", func(t *testing.T) {
+		result, err := parseDotenv("CERT=\"-----BEGIN-----\r\nabc\r\n-----END-----\"\nNEXT=1\n")
+		require.NoError(t, err)
+
+		assert.Equal(t, "-----BEGIN-----\nabc\n-----END-----", result["CERT"])
+		assert.Equal(t, "1", result["NEXT"])
+	})
+
+	t.Run("Expansion", func(t *testing.T) {
+		t.Setenv("DOTENV_TEST_USER", "from-env")
+		t.Setenv("DOTENV_TEST_HOST", "env-host")
+
+		result, err := parseDotenv(`
+DOTENV_TEST_HOST=file-host
+URL=postgres://${DOTENV_TEST_USER}@${DOTENV_TEST_HOST}:5432
+QUOTED="${DOTENV_TEST_HOST}/path"
+LITERAL='${DOTENV_TEST_HOST}'
+ESCAPED="\${DOTENV_TEST_HOST}"
+MISSING=${DOTENV_TEST_UNDEFINED}
+UNCLOSED=${DOTENV_TEST_HOST
+PLAIN=$DOTENV_TEST_HOST
+DOTENV_TEST_HOST=redefined
+AFTER=${DOTENV_TEST_HOST}
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, "postgres://from-env@file-host:5432", result["URL"])
+		assert.Equal(t, "file-host/path", result["QUOTED"])
+		assert.Equal(t, "${DOTENV_TEST_HOST}", result["LITERAL"])
+		assert.Equal(t, "${DOTENV_TEST_HOST}", result["ESCAPED"])
+		assert.Equal(t, "", result["MISSING"])
+		assert.Equal(t, "${DOTENV_TEST_HOST", result["UNCLOSED"])
+		assert.Equal(t, "$DOTENV_TEST_HOST", result["PLAIN"])
+		assert.Equal(t, "redefined", result["DOTENV_TEST_HOST"])
+		assert.Equal(t, "redefined", result["AFTER"])
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		invalid := map[string]string{
+			"missing equals":     "JUST_A_NAME",
+			"invalid name":       "1ABC=value",
+			"name with space":    "MY KEY=value",
+			"unterminated":       "A=\"open\nB=2",
+			"unterminated quote": "A='open",
+			"text after quote":   `A="value" extra`,
+			"export without key": "export =value",
+		}
+
+		for name, content := range invalid {
+			_, err := parseDotenv(content)
+			assert.Error(t, err, name)
+		}
+
+		_, err := parseDotenv("A=1\n\nB=\"open\nstill open")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 3")
+	})
+}
+
+// TestConfig_LoadFromFile_Dotenv tests loading dotenv files through the config API
+func TestConfig_LoadFromFile_Dotenv(t *testing.T) {
+	dir := t.TempDir()
+	envPath := filepath.Join(dir, ".env")
+
+	require.NoError(t, os.WriteFile(envPath, []byte(`
+DOTENV_LOAD_PORT=8080
+DOTENV_LOAD_DEBUG=true
+DOTENV_LOAD_URL="http://localhost:${DOTENV_LOAD_PORT}"
+`), 0o600))
+
+	t.Run("into configuration", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		require.NoError(t, c.LoadFromFile(envPath, &LoadOptions{IgnoreEnv: true}))
+
+		assert.Equal(t, 8080, c.GetInt("DOTENV_LOAD_PORT"))
+		assert.True(t, c.GetBool("DOTENV_LOAD_DEBUG"))
+		assert.Equal(t, "http://localhost:8080", c.GetString("DOTENV_LOAD_URL"))
+	})
+
+	t.Run("process environment wins over loaded keys", func(t *testing.T) {
+		t.Setenv("DOTENV_LOAD_PORT", "9090")
+
+		c, err := New()
+		require.NoError(t, err)
+
+		require.NoError(t, c.LoadFromFile(envPath, nil))
+		assert.Equal(t, 9090, c.GetInt("DOTENV_LOAD_PORT"))
+	})
+
+	t.Run("inject into process environment", func(t *testing.T) {
+		// t.Setenv registers cleanup of the variables injected below
+		t.Setenv("DOTENV_LOAD_PORT", "")
+		t.Setenv("DOTENV_LOAD_DEBUG", "")
+		t.Setenv("DOTENV_LOAD_URL", "")
+		os.Unsetenv("DOTENV_LOAD_PORT")
+		os.Unsetenv("DOTENV_LOAD_URL")
+
+		c, err := New()
+		require.NoError(t, err)
+
+		c.Set("kept", true)
+
+		require.NoError(t, c.LoadFromFile(envPath, &LoadOptions{InjectEnv: true}))
+
+		assert.Equal(t, "8080", os.Getenv("DOTENV_LOAD_PORT"))
+		assert.Equal(t, "http://localhost:8080", os.Getenv("DOTENV_LOAD_URL"))
+
+		// Variables already set, even to an empty string, are not replaced
+		value, set := os.LookupEnv("DOTENV_LOAD_DEBUG")
+		assert.True(t, set)
+		assert.Empty(t, value)
+
+		// The configuration itself is left unchanged
+		assert.True(t, c.GetBool("kept"))
+		assert.False(t, c.Has("DOTENV_LOAD_PORT"))
+	})
+
+	t.Run("inject before environment overrides", func(t *testing.T) {
+		t.Setenv("APP_SERVER__PORT", "")
+		os.Unsetenv("APP_SERVER__PORT")
+
+		overlayPath := filepath.Join(dir, ".env.local")
+		require.NoError(t, os.WriteFile(overlayPath, []byte("export APP_SERVER__PORT=7070\n"), 0o600))
+
+		yamlPath := filepath.Join(dir, "config.yaml")
+		require.NoError(t, os.WriteFile(yamlPath, []byte("server:\n  port: 8080\n"), 0o600))
+
+		c, err := New()
+		require.NoError(t, err)
+
+		err = c.LoadFromFiles([]string{yamlPath, overlayPath}, &LoadOptions{
+			InjectEnv: true,
+			Env:       &EnvOptions{Prefix: "APP_"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 7070, c.GetInt("server.port"))
+		assert.False(t, c.Has("APP_SERVER__PORT"))
+	})
+
+	t.Run("detected from file name", func(t *testing.T) {
+		for _, name := range []string{".env", "/srv/app/.env", ".env.local", ".ENV.production", "app.env"} {
+			assert.Equal(t, FormatDotenv, detectFormat(name), name)
+		}
+
+		assert.Equal(t, FormatJSON, detectFormat(".env.json"))
+		assert.Equal(t, FormatINI, detectFormat("environment.ini"))
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		badPath := filepath.Join(dir, "bad.env")
+		require.NoError(t, os.WriteFile(badPath, []byte("NOT VALID"), 0o600))
+
+		c, err := New()
+		require.NoError(t, err)
+
+		err = c.LoadFromFile(badPath, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse dotenv config")
+	})
+}
+
+// Benchmark Tests for dotenv_parser.go functions
+
+func BenchmarkParseDotenv(b *testing.B) {
+	content := `
+# Database settings
+export DB_HOST=localhost
+DB_PORT=5432
+DB_URL="postgres://${DB_HOST}:${DB_PORT}/app"
+SECRET='s3cr3t # literal'
+`
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := parseDotenv(content); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,307 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | env.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultEnvDelimiter separates nested key parts in environment variable names.
+const defaultEnvDelimiter = "__"
+
+// defaultEnvListSeparator separates list elements in environment variable values.
+const defaultEnvListSeparator = ","
+
+// loadFromMappedEnvironmentUnsafe overrides configuration values, including nested ones,
+// from environment variables named according to env.
+// This method assumes the caller holds the write lock.
+// Values are coerced to the type of the value they replace.
+func (c *Config) loadFromMappedEnvironmentUnsafe(env *EnvOptions) error {
+	if c == nil || env == nil {
+		return nil
+	}
+
+	consumed := make(map[string]bool)
+	err := c.overrideFromEnvironmentUnsafe(c.dataUnsafe(), nil, env, consumed)
+
+	if env.AllowNew && env.Prefix != "" {
+		c.createFromEnvironmentUnsafe(env, consumed)
+	}
+
+	return err
+}
+
+// overrideFromEnvironmentUnsafe walks data and replaces every value whose
+// environment variable is set. Maps are only descended when not overridden as a whole.
+// All conversion failures are reported together; the affected values are left unchanged.
+// This method assumes the caller holds the write lock.
+func (c *Config) overrideFromEnvironmentUnsafe(
+	data map[string]any, path []string, env *EnvOptions, consumed map[string]bool,
+) error {
+	var errs []error
+
+	for key, value := range data {
+		keyPath := append(path[:len(path):len(path)], key)
+		name := envVarName(keyPath, env)
+
+		if envValue := os.Getenv(name); envValue != "" {
+			consumed[name] = true
+
+			coerced, err := coerceEnvValue(envValue, value, env.ListSeparator, c.timeLayouts)
+			if err != nil {
+				errs = append(errs, envValueError(name, strings.Join(keyPath, "."), err))
+
+				continue
+			}
+
+			data[key] = coerced
+
+			continue
+		}
+
+		if nestedMap, ok := value.(map[string]any); ok {
+			if err := c.overrideFromEnvironmentUnsafe(nestedMap, keyPath, env, consumed); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// createFromEnvironmentUnsafe adds keys for prefixed environment variables
+// that did not match any existing configuration key.
+// This method assumes the caller holds the write lock.
+func (c *Config) createFromEnvironmentUnsafe(env *EnvOptions, consumed map[string]bool) {
+	delimiter := env.Delimiter
+	if delimiter == "" {
+		delimiter = defaultEnvDelimiter
+	}
+
+	for _, entry := range os.Environ() {
+		name, value, found := strings.Cut(entry, "=")
+		if !found || value == "" || consumed[name] || !strings.HasPrefix(name, env.Prefix) {
+			continue
+		}
+
+		parts := strings.Split(strings.TrimPrefix(name, env.Prefix), delimiter)
+		if !validEnvKeyParts(parts) {
+			continue
+		}
+
+		segments := make([]pathSegment, len(parts))
+
+		for i, part := range parts {
+			if env.KeyCase != EnvKeyPreserve {
+				part = strings.ToLower(part)
+			}
+
+			segments[i] = keySegment(part)
+		}
+
+		setPathValue(c.writableDataUnsafe(), segments, value, c.caseInsensitive)
+	}
+}
+
+// envVarName builds the environment variable name for a key path.
+func envVarName(path []string, env *EnvOptions) string {
+	delimiter := env.Delimiter
+	if delimiter == "" {
+		delimiter = defaultEnvDelimiter
+	}
+
+	name := strings.Join(path, delimiter)
+
+	switch env.KeyCase {
+	case EnvKeyUpper:
+		name = strings.ToUpper(name)
+	case EnvKeyLower:
+		name = strings.ToLower(name)
+	case EnvKeyPreserve:
+	}
+
+	return env.Prefix + name
+}
+
+// validEnvKeyParts reports whether all parts of a variable name can be used as key parts.
+func validEnvKeyParts(parts []string) bool {
+	for _, part := range parts {
+		if part == "" {
+			return false
+		}
+	}
+
+	return len(parts) > 0
+}
+
+// coerceEnvValue converts an environment variable value to the type of the value it replaces.
+// Strings and missing values keep the raw string, lists are split on separator
+// (or parsed as a JSON array), maps are parsed as JSON objects and times are tried
+// with timeLayouts before the built-in layouts.
+func coerceEnvValue(raw string, existing any, separator string, timeLayouts []string) (any, error) {
+	if existing == nil {
+		return raw, nil
+	}
+
+	switch existing.(type) {
+	case time.Duration:
+		return parseEnvDuration(raw)
+	case time.Time:
+		return parseEnvTime(raw, timeLayouts)
+	}
+
+	value := reflect.ValueOf(existing)
+	trimmed := strings.TrimSpace(raw)
+
+	switch value.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(raw).Convert(value.Type()).Interface(), nil
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(trimmed)
+		if err != nil {
+			return nil, err
+		}
+
+		return reflect.ValueOf(parsed).Convert(value.Type()).Interface(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := parseIntLiteral(trimmed)
+		if err != nil {
+			return nil, err
+		}
+
+		if value.OverflowInt(parsed) {
+			return nil, fmt.Errorf("value %d overflows %s", parsed, value.Type())
+		}
+
+		return reflect.ValueOf(parsed).Convert(value.Type()).Interface(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := parseUintLiteral(trimmed)
+		if err != nil {
+			return nil, err
+		}
+
+		if value.OverflowUint(parsed) {
+			return nil, fmt.Errorf("value %d overflows %s", parsed, value.Type())
+		}
+
+		return reflect.ValueOf(parsed).Convert(value.Type()).Interface(), nil
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(trimmed, value.Type().Bits())
+		if err != nil {
+			return nil, err
+		}
+
+		return reflect.ValueOf(parsed).Convert(value.Type()).Interface(), nil
+	case reflect.Slice:
+		return coerceEnvList(raw, value, separator, timeLayouts)
+	case reflect.Map:
+		result := reflect.New(value.Type())
+		if err := json.Unmarshal([]byte(trimmed), result.Interface()); err != nil {
+			return nil, fmt.Errorf("expected JSON object: %w", err)
+		}
+
+		return result.Elem().Interface(), nil
+	default:
+		return nil, fmt.Errorf("unsupported type %T", existing)
+	}
+}
+
+// coerceEnvList converts an environment variable value to a slice of the same type as list.
+// Elements of []any lists are converted to the type of the first existing element.
+func coerceEnvList(raw string, list reflect.Value, separator string, timeLayouts []string) (any, error) {
+	trimmed := strings.TrimSpace(raw)
+
+	if strings.HasPrefix(trimmed, "[") {
+		result := reflect.New(list.Type())
+		if err := json.Unmarshal([]byte(trimmed), result.Interface()); err != nil {
+			return nil, fmt.Errorf("expected JSON array: %w", err)
+		}
+
+		return result.Elem().Interface(), nil
+	}
+
+	if separator == "" {
+		separator = defaultEnvListSeparator
+	}
+
+	elemType := list.Type().Elem()
+
+	var template any
+	if elemType.Kind() != reflect.Interface {
+		template = reflect.Zero(elemType).Interface()
+	} else if list.Len() > 0 {
+		template = list.Index(0).Interface()
+	}
+
+	parts := strings.Split(raw, separator)
+	result := reflect.MakeSlice(list.Type(), 0, len(parts))
+
+	for i, part := range parts {
+		elem, err := coerceEnvValue(strings.TrimSpace(part), template, separator, timeLayouts)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+
+		result = reflect.Append(result, reflect.ValueOf(elem).Convert(elemType))
+	}
+
+	return result.Interface(), nil
+}
+
+// parseEnvDuration parses a duration string like "30s", or an integer number of seconds.
+func parseEnvDuration(raw string) (time.Duration, error) {
+	trimmed := strings.TrimSpace(raw)
+
+	if parsed, err := time.ParseDuration(trimmed); err == nil {
+		return parsed, nil
+	}
+
+	seconds, err := strconv.Atoi(trimmed)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", raw)
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// parseEnvTime parses a time string with timeLayouts, then as an RFC 3339,
+// date-time or date-only string, like GetTime.
+func parseEnvTime(raw string, timeLayouts []string) (time.Time, error) {
+	trimmed := strings.TrimSpace(raw)
+
+	for _, layouts := range [][]string{timeLayouts, builtinTimeLayouts} {
+		for _, layout := range layouts {
+			if parsed, err := time.Parse(layout, trimmed); err == nil {
+				return parsed, nil
+			}
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q", raw)
+}
+
+// envValueError describes an environment variable that could not be converted.
+func envValueError(name, key string, err error) error {
+	return fmt.Errorf("%w: %s for key %q: %w", ErrInvalidEnvValue, name, key, err)
+}
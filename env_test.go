@@ -0,0 +1,348 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | env_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMappedEnvironment_Comprehensive tests environment overrides driven by EnvOptions
+func TestMappedEnvironment_Comprehensive(t *testing.T) {
+	newConfig := func(t *testing.T) *Config {
+		t.Helper()
+
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{
+			"app_name": "app",
+			"database": map[string]any{
+				"host":    "localhost",
+				"primary": map[string]any{"user": "admin"},
+			},
+		})
+
+		return c
+	}
+
+	t.Run("NestedKeysWithPrefix", func(t *testing.T) {
+		t.Setenv("MYAPP_APP_NAME", "env-app")
+		t.Setenv("MYAPP_DATABASE__HOST", "db.example.com")
+		t.Setenv("MYAPP_DATABASE__PRIMARY__USER", "root")
+		t.Setenv("DATABASE__HOST", "unprefixed")
+
+		c := newConfig(t)
+		require.NoError(t, c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "MYAPP_"}))
+
+		assert.Equal(t, "env-app", c.GetString("app_name"))
+		assert.Equal(t, "db.example.com", c.GetString("database.host"))
+		assert.Equal(t, "root", c.GetString("database.primary.user"))
+	})
+
+	t.Run("CustomDelimiter", func(t *testing.T) {
+		t.Setenv("SVC_DATABASE_HOST", "custom")
+
+		c := newConfig(t)
+		require.NoError(t, c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "SVC_", Delimiter: "_"}))
+
+		assert.Equal(t, "custom", c.GetString("database.host"))
+	})
+
+	t.Run("KeyCase", func(t *testing.T) {
+		t.Setenv("lower_database__host", "lower")
+		t.Setenv("Preserve_database__primary__user", "preserved")
+
+		c := newConfig(t)
+		require.NoError(t, c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "lower_", KeyCase: EnvKeyLower}))
+		c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "Preserve_", KeyCase: EnvKeyPreserve})
+
+		assert.Equal(t, "lower", c.GetString("database.host"))
+		assert.Equal(t, "preserved", c.GetString("database.primary.user"))
+	})
+
+	t.Run("WholeMapOverride", func(t *testing.T) {
+		t.Setenv("MAPAPP_DATABASE", `{"host": "json-host"}`)
+
+		c := newConfig(t)
+		require.NoError(t, c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "MAPAPP_"}))
+
+		assert.Equal(t, "json-host", c.GetString("database.host"))
+		assert.False(t, c.Has("database.primary"))
+	})
+
+	t.Run("AllowNew", func(t *testing.T) {
+		t.Setenv("NEWAPP_DATABASE__PORT", "5432")
+		t.Setenv("NEWAPP_CACHE__REDIS__HOST", "redis")
+		t.Setenv("NEWAPP_DATABASE__HOST", "overridden")
+		t.Setenv("NEWAPP_BROKEN____KEY", "skipped")
+
+		c := newConfig(t)
+		require.NoError(t, c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "NEWAPP_", AllowNew: true}))
+
+		assert.Equal(t, "5432", c.GetString("database.port"))
+		assert.Equal(t, "redis", c.GetString("cache.redis.host"))
+		assert.Equal(t, "overridden", c.GetString("database.host"))
+		assert.Equal(t, "admin", c.GetString("database.primary.user"))
+		assert.False(t, c.Has("broken"))
+	})
+
+	t.Run("AllowNewWithoutPrefix", func(t *testing.T) {
+		t.Setenv("UNRELATED_VARIABLE", "value")
+
+		c := newConfig(t)
+		require.NoError(t, c.loadFromMappedEnvironmentUnsafe(&EnvOptions{AllowNew: true}))
+
+		assert.False(t, c.Has("unrelated_variable"))
+	})
+
+	t.Run("LoadFromFile", func(t *testing.T) {
+		t.Setenv("FILEAPP_SERVER__HOST", "0.0.0.0")
+		t.Setenv("FILEAPP_SERVER__TIMEOUT", "5s")
+
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("server:\n  host: localhost\n"), 0o600))
+
+		c, err := New()
+		require.NoError(t, err)
+
+		err = c.LoadFromFile(path, &LoadOptions{
+			Env:          &EnvOptions{Prefix: "FILEAPP_", AllowNew: true},
+			RequiredKeys: []string{"server.timeout"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "0.0.0.0", c.GetString("server.host"))
+		assert.Equal(t, "5s", c.GetString("server.timeout"))
+
+		// IgnoreEnv still disables the mapping
+		err = c.LoadFromFile(path, &LoadOptions{IgnoreEnv: true, Env: &EnvOptions{Prefix: "FILEAPP_"}})
+		require.NoError(t, err)
+		assert.Equal(t, "localhost", c.GetString("server.host"))
+	})
+}
+
+// TestEnvironment_Coercion tests that environment values keep the type of the value they replace
+func TestEnvironment_Coercion(t *testing.T) {
+	newConfig := func(t *testing.T) *Config {
+		t.Helper()
+
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{
+			"server": map[string]any{
+				"port":    8080,
+				"load":    0.5,
+				"debug":   false,
+				"timeout": 30 * time.Second,
+				"name":    "srv",
+				"hosts":   []any{"a", "b"},
+				"ports":   []any{80, 443},
+				"weights": []float64{1.5},
+				"names":   []string{"x"},
+				"limits":  map[string]any{"rps": 10},
+				"id":      uint16(1),
+			},
+		})
+
+		return c
+	}
+
+	t.Run("ScalarTypes", func(t *testing.T) {
+		t.Setenv("CO_SERVER__PORT", "9090")
+		t.Setenv("CO_SERVER__LOAD", "0.75")
+		t.Setenv("CO_SERVER__DEBUG", "true")
+		t.Setenv("CO_SERVER__TIMEOUT", "1m")
+		t.Setenv("CO_SERVER__NAME", " spaced ")
+		t.Setenv("CO_SERVER__ID", "0xFFFF")
+
+		c := newConfig(t)
+		require.NoError(t, c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "CO_"}))
+
+		server := c.GetNestedMap("server")
+		assert.Equal(t, 9090, server["port"])
+		assert.Equal(t, 0.75, server["load"])
+		assert.Equal(t, true, server["debug"])
+		assert.Equal(t, time.Minute, server["timeout"])
+		assert.Equal(t, " spaced ", server["name"])
+		assert.Equal(t, uint16(65535), server["id"])
+	})
+
+	t.Run("DurationSeconds", func(t *testing.T) {
+		t.Setenv("DS_SERVER__TIMEOUT", "15")
+
+		c := newConfig(t)
+		require.NoError(t, c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "DS_"}))
+
+		assert.Equal(t, 15*time.Second, c.GetDuration("server.timeout"))
+	})
+
+	t.Run("Times", func(t *testing.T) {
+		t.Setenv("TM_SERVER__STARTED", "2026-10-16T12:30:00+02:00")
+		t.Setenv("TM_SERVER__EXPIRES", "2027-01-31")
+		t.Setenv("TM_SERVER__CHECKED", "not a time")
+
+		c := newConfig(t)
+
+		started := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		c.Set("server.started", started)
+		c.Set("server.expires", started)
+		c.Set("server.checked", started)
+
+		err := c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "TM_"})
+		require.ErrorIs(t, err, ErrInvalidEnvValue)
+		assert.Contains(t, err.Error(), "TM_SERVER__CHECKED")
+		assert.NotContains(t, err.Error(), "TM_SERVER__STARTED")
+
+		server := c.GetNestedMap("server")
+		assert.True(t, time.Date(2026, 10, 16, 10, 30, 0, 0, time.UTC).Equal(server["started"].(time.Time)))
+		assert.Equal(t, time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC), server["expires"])
+		assert.Equal(t, started, server["checked"])
+	})
+
+	t.Run("TimeLayouts", func(t *testing.T) {
+		t.Setenv("TL_SERVER__STARTED", "16/10/2026 12:30")
+		t.Setenv("TL_SERVER__EXPIRES", "2027-01-31")
+
+		c, err := New(WithTimeLayouts("02/01/2006 15:04"))
+		require.NoError(t, err)
+
+		c.SetNestedDefaults(map[string]any{
+			"server": map[string]any{"started": time.Time{}, "expires": time.Time{}},
+		})
+
+		require.NoError(t, c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "TL_"}))
+		assert.Equal(t, time.Date(2026, 10, 16, 12, 30, 0, 0, time.UTC), c.GetTime("server.started"))
+		assert.Equal(t, time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC), c.GetTime("server.expires"))
+	})
+
+	t.Run("TOMLDateTime", func(t *testing.T) {
+		t.Setenv("released", "2027-01-31T08:00:00Z")
+
+		path := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(path, []byte("released = 2026-10-16T12:00:00Z\n"), 0o600))
+
+		c, err := New()
+		require.NoError(t, err)
+
+		require.NoError(t, c.LoadFromFile(path, nil))
+		assert.Equal(t, time.Date(2027, 1, 31, 8, 0, 0, 0, time.UTC), c.GetTime("released"))
+	})
+
+	t.Run("Lists", func(t *testing.T) {
+		t.Setenv("LS_SERVER__HOSTS", "c, d,e")
+		t.Setenv("LS_SERVER__PORTS", "8080;8443")
+		t.Setenv("LS_SERVER__WEIGHTS", "0.1;0.9")
+		t.Setenv("LS_SERVER__NAMES", `["y", "z"]`)
+
+		c := newConfig(t)
+		require.NoError(t, c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "LS_", ListSeparator: ";"}))
+
+		server := c.GetNestedMap("server")
+		assert.Equal(t, []any{"c, d,e"}, server["hosts"])
+		assert.Equal(t, []any{8080, 8443}, server["ports"])
+		assert.Equal(t, []float64{0.1, 0.9}, server["weights"])
+		assert.Equal(t, []string{"y", "z"}, server["names"])
+	})
+
+	t.Run("DefaultListSeparator", func(t *testing.T) {
+		t.Setenv("DL_SERVER__HOSTS", "c, d")
+
+		c := newConfig(t)
+		require.NoError(t, c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "DL_"}))
+
+		assert.Equal(t, []string{"c", "d"}, c.GetStringSlice("server.hosts"))
+	})
+
+	t.Run("Maps", func(t *testing.T) {
+		t.Setenv("MP_SERVER__LIMITS", `{"rps": 100, "burst": 20}`)
+
+		c := newConfig(t)
+		require.NoError(t, c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "MP_"}))
+
+		assert.Equal(t, 100, c.GetInt("server.limits.rps"))
+		assert.Equal(t, 20, c.GetInt("server.limits.burst"))
+	})
+
+	t.Run("InvalidValues", func(t *testing.T) {
+		t.Setenv("BAD_SERVER__PORT", "80a")
+		t.Setenv("BAD_SERVER__DEBUG", "maybe")
+		t.Setenv("BAD_SERVER__PORTS", "80,http")
+		t.Setenv("BAD_SERVER__LIMITS", "rps=10")
+		t.Setenv("BAD_SERVER__ID", "-1")
+		t.Setenv("BAD_SERVER__NAME", "valid")
+
+		c := newConfig(t)
+		err := c.loadFromMappedEnvironmentUnsafe(&EnvOptions{Prefix: "BAD_"})
+		require.ErrorIs(t, err, ErrInvalidEnvValue)
+
+		for _, name := range []string{
+			"BAD_SERVER__PORT", "BAD_SERVER__DEBUG", "BAD_SERVER__PORTS", "BAD_SERVER__LIMITS", "BAD_SERVER__ID",
+		} {
+			assert.Contains(t, err.Error(), name)
+		}
+
+		assert.Contains(t, err.Error(), `"server.port"`)
+		assert.Contains(t, err.Error(), "element 1")
+
+		// Invalid values keep their original type and value, valid ones are applied
+		assert.Equal(t, 8080, c.GetNestedMap("server")["port"])
+		assert.Equal(t, "valid", c.GetString("server.name"))
+	})
+
+	t.Run("LoadFromFileFails", func(t *testing.T) {
+		t.Setenv("server_port", "eighty")
+
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("server_port: 8080\n"), 0o600))
+
+		c, err := New()
+		require.NoError(t, err)
+
+		err = c.LoadFromFile(path, nil)
+		require.ErrorIs(t, err, ErrInvalidEnvValue)
+		assert.Contains(t, err.Error(), "server_port")
+	})
+}
+
+// TestEnvVarName tests the mapping of key paths to environment variable names
+func TestEnvVarName(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     []string
+		env      EnvOptions
+		expected string
+	}{
+		{"Defaults", []string{"database", "host"}, EnvOptions{}, "DATABASE__HOST"},
+		{"Prefix", []string{"port"}, EnvOptions{Prefix: "APP_"}, "APP_PORT"},
+		{"Delimiter", []string{"a", "b", "c"}, EnvOptions{Delimiter: "_"}, "A_B_C"},
+		{"Lower", []string{"Server", "Port"}, EnvOptions{KeyCase: EnvKeyLower}, "server__port"},
+		{"Preserve", []string{"Server", "Port"}, EnvOptions{KeyCase: EnvKeyPreserve}, "Server__Port"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, envVarName(tt.path, &tt.env))
+		})
+	}
+}
@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | main.go
 	::  ::          ::  ::    Created  | 2025-08-19
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -29,49 +29,53 @@ import (
 
 // ServiceConfig represents microservice configuration
 type ServiceConfig struct {
-	Name      string
-	Version   string
-	Port      int
-	Health    HealthConfig
-	Metrics   MetricsConfig
-	Tracing   TracingConfig
-	Discovery ServiceDiscoveryConfig
-	Circuit   CircuitBreakerConfig
+	Service   ServiceInfo            `config:"service"`
+	Health    HealthConfig           `config:"health"`
+	Metrics   MetricsConfig          `config:"metrics"`
+	Tracing   TracingConfig          `config:"tracing"`
+	Discovery ServiceDiscoveryConfig `config:"discovery"`
+	Circuit   CircuitBreakerConfig   `config:"circuit"`
+}
+
+type ServiceInfo struct {
+	Name    string `config:"name"`
+	Version string `config:"version"`
+	Port    int    `config:"port"`
 }
 
 type HealthConfig struct {
-	Enabled  bool
-	Endpoint string
-	Timeout  time.Duration
+	Enabled  bool          `config:"enabled"`
+	Endpoint string        `config:"endpoint"`
+	Timeout  time.Duration `config:"timeout"`
 }
 
 type MetricsConfig struct {
-	Enabled  bool
-	Endpoint string
-	Interval time.Duration
+	Enabled  bool          `config:"enabled"`
+	Endpoint string        `config:"endpoint"`
+	Interval time.Duration `config:"interval"`
 }
 
 type TracingConfig struct {
-	Enabled     bool
-	ServiceName string
-	Endpoint    string
-	SampleRate  float64
+	Enabled     bool    `config:"enabled"`
+	ServiceName string  `config:"service_name"`
+	Endpoint    string  `config:"endpoint"`
+	SampleRate  float64 `config:"sample_rate"`
 }
 
 type ServiceDiscoveryConfig struct {
-	Enabled bool
-	Type    string // consul, etcd, kubernetes
-	Address string
-	TTL     time.Duration
+	Enabled bool          `config:"enabled"`
+	Type    string        `config:"type"` // consul, etcd, kubernetes
+	Address string        `config:"address"`
+	TTL     time.Duration `config:"ttl"`
 }
 
 type CircuitBreakerConfig struct {
-	Enabled          bool
-	MaxRequests      int
-	Interval         time.Duration
-	Timeout          time.Duration
-	FailureThreshold int
-	SuccessThreshold int
+	Enabled          bool          `config:"enabled"`
+	MaxRequests      int           `config:"max_requests"`
+	Interval         time.Duration `config:"interval"`
+	Timeout          time.Duration `config:"timeout"`
+	FailureThreshold int           `config:"failure_threshold"`
+	SuccessThreshold int           `config:"success_threshold"`
 }
 
 func main() {
@@ -140,7 +144,8 @@ func loadMicroserviceConfig(environment string) *config.Config {
 
 	if !loaded {
 		fmt.Printf("  ⚠️  No config file found, using %s defaults\n", environment)
-		cfg.LoadFromMap(defaults)
+		// Dotted default keys are stored as nested sections for Unmarshal
+		cfg.SetNestedDefaults(defaults)
 	}
 
 	return cfg
@@ -244,50 +249,18 @@ func validateMicroserviceConfig(data map[string]any, environment string) error {
 }
 
 func parseMicroserviceConfig(cfg *config.Config) ServiceConfig {
-	return ServiceConfig{
-		Name:    cfg.GetString("service.name"),
-		Version: cfg.GetString("service.version"),
-		Port:    cfg.GetInt("service.port"),
-
-		Health: HealthConfig{
-			Enabled:  cfg.GetBool("health.enabled"),
-			Endpoint: cfg.GetString("health.endpoint"),
-			Timeout:  cfg.GetDuration("health.timeout"),
-		},
+	var serviceConfig ServiceConfig
 
-		Metrics: MetricsConfig{
-			Enabled:  cfg.GetBool("metrics.enabled"),
-			Endpoint: cfg.GetString("metrics.endpoint"),
-			Interval: cfg.GetDuration("metrics.interval"),
-		},
-
-		Tracing: TracingConfig{
-			Enabled:     cfg.GetBool("tracing.enabled"),
-			ServiceName: cfg.GetString("tracing.service_name"),
-			Endpoint:    cfg.GetString("tracing.endpoint"),
-			SampleRate:  cfg.GetFloat64("tracing.sample_rate"),
-		},
-
-		Discovery: ServiceDiscoveryConfig{
-			Enabled: cfg.GetBool("discovery.enabled"),
-			Type:    cfg.GetString("discovery.type"),
-			Address: cfg.GetString("discovery.address"),
-			TTL:     cfg.GetDuration("discovery.ttl"),
-		},
-
-		Circuit: CircuitBreakerConfig{
-			Enabled:          cfg.GetBool("circuit.enabled"),
-			MaxRequests:      cfg.GetInt("circuit.max_requests"),
-			Interval:         cfg.GetDuration("circuit.interval"),
-			Timeout:          cfg.GetDuration("circuit.timeout"),
-			FailureThreshold: cfg.GetInt("circuit.failure_threshold"),
-			SuccessThreshold: cfg.GetInt("circuit.success_threshold"),
-		},
+	// Each section is decoded into its nested struct using the `config` tags
+	if err := cfg.Unmarshal(&serviceConfig); err != nil {
+		log.Fatalf("Failed to parse configuration: %v", err)
 	}
+
+	return serviceConfig
 }
 
 func displayMicroserviceConfig(sc ServiceConfig) {
-	fmt.Printf("  📦 Service: %s v%s (Port: %d)\n", sc.Name, sc.Version, sc.Port)
+	fmt.Printf("  📦 Service: %s v%s (Port: %d)\n", sc.Service.Name, sc.Service.Version, sc.Service.Port)
 
 	fmt.Printf("  ❤️  Health Check: %v", sc.Health.Enabled)
 	if sc.Health.Enabled {
@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | main.go
 	::  ::          ::  ::    Created  | 2025-08-19
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -28,40 +28,45 @@ import (
 
 // ServerConfig represents HTTP server configuration
 type ServerConfig struct {
-	Host           string
-	Port           int
-	ReadTimeout    time.Duration
-	WriteTimeout   time.Duration
-	IdleTimeout    time.Duration
-	MaxHeaderBytes int
-	SSLEnabled     bool
-	SSLCertFile    string
-	SSLKeyFile     string
+	Host           string        `config:"host"`
+	Port           int           `config:"port"`
+	ReadTimeout    time.Duration `config:"read_timeout"`
+	WriteTimeout   time.Duration `config:"write_timeout"`
+	IdleTimeout    time.Duration `config:"idle_timeout"`
+	MaxHeaderBytes int           `config:"max_header_bytes"`
+	SSLEnabled     bool          `config:"ssl_enabled"`
+	SSLCertFile    string        `config:"ssl_cert_file"`
+	SSLKeyFile     string        `config:"ssl_key_file"`
 }
 
 // DatabaseConfig represents database configuration
 type DatabaseConfig struct {
-	Driver      string
-	Host        string
-	Port        int
-	Name        string
-	User        string
-	Password    string
-	SSLMode     string
-	MaxOpenConn int
-	MaxIdleConn int
-	MaxLifetime time.Duration
+	Driver      string        `config:"driver"`
+	Host        string        `config:"host"`
+	Port        int           `config:"port"`
+	Name        string        `config:"name"`
+	User        string        `config:"user"`
+	Password    string        `config:"password"`
+	SSLMode     string        `config:"ssl_mode"`
+	MaxOpenConn int           `config:"max_open_conn"`
+	MaxIdleConn int           `config:"max_idle_conn"`
+	MaxLifetime time.Duration `config:"max_lifetime"`
+}
+
+// AppSettings represents general application settings
+type AppSettings struct {
+	Name        string `config:"name"`
+	Version     string `config:"version"`
+	Environment string `config:"environment"`
+	Debug       bool   `config:"debug"`
+	LogLevel    string `config:"log_level"`
 }
 
 // AppConfig represents complete application configuration
 type AppConfig struct {
-	AppName     string
-	Version     string
-	Environment string
-	Debug       bool
-	LogLevel    string
-	Server      ServerConfig
-	Database    DatabaseConfig
+	App      AppSettings    `config:"app"`
+	Server   ServerConfig   `config:"server"`
+	Database DatabaseConfig `config:"database"`
 }
 
 func main() {
@@ -177,53 +182,29 @@ func loadConfiguration() *config.Config {
 	if !loaded {
 		fmt.Println("⚠️  No configuration file found, using defaults")
 		// Apply defaults manually since no file was loaded
-		cfg.LoadFromMap(defaults)
+		cfg.SetNestedDefaults(defaults)
 	}
 
 	return cfg
 }
 
 func parseConfiguration(cfg *config.Config) AppConfig {
-	return AppConfig{
-		AppName:     cfg.GetString("app.name"),
-		Version:     cfg.GetString("app.version"),
-		Environment: cfg.GetString("app.environment"),
-		Debug:       cfg.GetBool("app.debug"),
-		LogLevel:    cfg.GetString("app.log_level"),
-
-		Server: ServerConfig{
-			Host:           cfg.GetString("server.host"),
-			Port:           cfg.GetInt("server.port"),
-			ReadTimeout:    cfg.GetDuration("server.read_timeout"),
-			WriteTimeout:   cfg.GetDuration("server.write_timeout"),
-			IdleTimeout:    cfg.GetDuration("server.idle_timeout"),
-			MaxHeaderBytes: cfg.GetInt("server.max_header_bytes"),
-			SSLEnabled:     cfg.GetBool("server.ssl_enabled"),
-			SSLCertFile:    cfg.GetString("server.ssl_cert_file"),
-			SSLKeyFile:     cfg.GetString("server.ssl_key_file"),
-		},
+	var appConfig AppConfig
 
-		Database: DatabaseConfig{
-			Driver:      cfg.GetString("database.driver"),
-			Host:        cfg.GetString("database.host"),
-			Port:        cfg.GetInt("database.port"),
-			Name:        cfg.GetString("database.name"),
-			User:        cfg.GetString("database.user"),
-			Password:    cfg.GetString("database.password"),
-			SSLMode:     cfg.GetString("database.ssl_mode"),
-			MaxOpenConn: cfg.GetInt("database.max_open_conn"),
-			MaxIdleConn: cfg.GetInt("database.max_idle_conn"),
-			MaxLifetime: cfg.GetDuration("database.max_lifetime"),
-		},
+	// Nested structs are decoded from their sections using the `config` tags
+	if err := cfg.Unmarshal(&appConfig); err != nil {
+		log.Fatalf("Failed to parse configuration: %v", err)
 	}
+
+	return appConfig
 }
 
 func displayConfiguration(appConfig AppConfig) {
 	fmt.Printf("📋 Application Configuration:\n")
-	fmt.Printf("   Name: %s v%s\n", appConfig.AppName, appConfig.Version)
-	fmt.Printf("   Environment: %s\n", appConfig.Environment)
-	fmt.Printf("   Debug Mode: %v\n", appConfig.Debug)
-	fmt.Printf("   Log Level: %s\n", appConfig.LogLevel)
+	fmt.Printf("   Name: %s v%s\n", appConfig.App.Name, appConfig.App.Version)
+	fmt.Printf("   Environment: %s\n", appConfig.App.Environment)
+	fmt.Printf("   Debug Mode: %v\n", appConfig.App.Debug)
+	fmt.Printf("   Log Level: %s\n", appConfig.App.LogLevel)
 	fmt.Println()
 
 	fmt.Printf("🌐 Server Configuration:\n")
@@ -262,7 +243,7 @@ func createServer(appConfig AppConfig) {
   "environment": "%s",
   "debug": %v,
   "timestamp": "%s"
-}`, appConfig.AppName, appConfig.Version, appConfig.Environment, appConfig.Debug, time.Now().UTC().Format(time.RFC3339))
+}`, appConfig.App.Name, appConfig.App.Version, appConfig.App.Environment, appConfig.App.Debug, time.Now().UTC().Format(time.RFC3339))
 
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
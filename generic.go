@@ -0,0 +1,72 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | generic.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Get retrieves the value at key converted to T using the same rules as the getters
+// and Unmarshal. Unlike the getters, it never falls back to a default: a missing key
+// returns an error wrapping ErrKeyNotFound, and a value that cannot be converted
+// returns an error wrapping ErrTypeMismatch.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.port").
+func Get[T any](c *Config, key string) (T, error) {
+	var result T
+
+	if c == nil {
+		return result, ErrConfigNil
+	}
+
+	c.locker().RLock()
+	defer c.locker().RUnlock()
+
+	value, exists := c.lookupUnsafe(key)
+	if !exists {
+		return result, fmt.Errorf("%w: %q", ErrKeyNotFound, key)
+	}
+
+	if value == nil {
+		// Only types that can hold nil accept a null value
+		switch reflect.TypeFor[T]().Kind() {
+		case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice:
+			return result, nil
+		default:
+			return result, typeMismatchError(key, value, fmt.Errorf("cannot convert to %T", result))
+		}
+	}
+
+	if err := decodeInto(key, value, &result, c.caseInsensitive); err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	return result, nil
+}
+
+// MustGet is like Get but panics if the key is missing or cannot be converted.
+// It is intended for values that must be valid for the program to start.
+func MustGet[T any](c *Config, key string) T {
+	result, err := Get[T](c, key)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
@@ -0,0 +1,163 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | generic_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGet_Comprehensive tests the generic typed accessor
+func TestGet_Comprehensive(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"name":  "app",
+		"port":  8080,
+		"ratio": "0.25",
+		"debug": "true",
+		"server": map[string]any{
+			"timeout": "30s",
+			"hosts":   []any{"a", "b"},
+			"limits":  map[string]any{"rps": 10},
+			"bad":     "80a",
+			"small":   300,
+		},
+		"nothing": nil,
+	})
+
+	t.Run("Conversions", func(t *testing.T) {
+		name, err := Get[string](c, "name")
+		require.NoError(t, err)
+		assert.Equal(t, "app", name)
+
+		port, err := Get[int](c, "port")
+		require.NoError(t, err)
+		assert.Equal(t, 8080, port)
+
+		port64, err := Get[int64](c, "port")
+		require.NoError(t, err)
+		assert.Equal(t, int64(8080), port64)
+
+		ratio, err := Get[float64](c, "ratio")
+		require.NoError(t, err)
+		assert.Equal(t, 0.25, ratio)
+
+		debug, err := Get[bool](c, "debug")
+		require.NoError(t, err)
+		assert.True(t, debug)
+
+		timeout, err := Get[time.Duration](c, "server.timeout")
+		require.NoError(t, err)
+		assert.Equal(t, 30*time.Second, timeout)
+
+		hosts, err := Get[[]string](c, "server.hosts")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, hosts)
+
+		limits, err := Get[map[string]int](c, "server.limits")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"rps": 10}, limits)
+
+		type serverConfig struct {
+			Timeout time.Duration `config:"timeout"`
+		}
+
+		server, err := Get[serverConfig](c, "server")
+		require.NoError(t, err)
+		assert.Equal(t, 30*time.Second, server.Timeout)
+	})
+
+	t.Run("KeyNotFound", func(t *testing.T) {
+		_, err := Get[int](c, "server.missing")
+		require.ErrorIs(t, err, ErrKeyNotFound)
+		assert.NotErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), "server.missing")
+	})
+
+	t.Run("TypeMismatch", func(t *testing.T) {
+		value, err := Get[int](c, "server.bad")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.NotErrorIs(t, err, ErrKeyNotFound)
+		assert.Contains(t, err.Error(), `"server.bad"`)
+		assert.Contains(t, err.Error(), "string")
+		assert.Zero(t, value)
+
+		_, err = Get[int8](c, "server.small")
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+
+		_, err = Get[[]int](c, "server.limits")
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+
+		_, err = Get[string](c, "server.limits")
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+
+		_, err = Get[string](c, "server.hosts")
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+	})
+
+	t.Run("NilValue", func(t *testing.T) {
+		_, err := Get[int](c, "nothing")
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+
+		value, err := Get[any](c, "nothing")
+		require.NoError(t, err)
+		assert.Nil(t, value)
+
+		list, err := Get[[]string](c, "nothing")
+		require.NoError(t, err)
+		assert.Nil(t, list)
+	})
+
+	t.Run("MustGet", func(t *testing.T) {
+		assert.Equal(t, 8080, MustGet[int](c, "port"))
+
+		assert.PanicsWithError(t, `configuration key not found: "missing"`, func() {
+			MustGet[int](c, "missing")
+		})
+		assert.Panics(t, func() {
+			MustGet[bool](c, "name")
+		})
+	})
+
+	t.Run("NilConfig", func(t *testing.T) {
+		var nilConfig *Config
+
+		_, err := Get[string](nilConfig, "any")
+		assert.ErrorIs(t, err, ErrConfigNil)
+	})
+}
+
+// Benchmark Tests for generic.go functions
+
+func BenchmarkGet(b *testing.B) {
+	c, err := New()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	c.Set("server.port", 8080)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Get[int](c, "server.port")
+	}
+}
@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | getters.go
 	::  ::          ::  ::    Created  | 2025-08-19
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -19,337 +19,274 @@ package config
 import (
 	"fmt"
 	"maps"
-	"strconv"
-	"strings"
+	"reflect"
+	"slices"
 	"time"
 )
 
 // GetString retrieves a string value with an optional default.
 // Supports both flat keys ("key") and nested keys with dot notation ("server.host").
 func (c *Config) GetString(key string, defaultValue ...string) string {
-	if c == nil {
-		if len(defaultValue) > 0 {
-			return defaultValue[0]
-		}
+	return getOrDefault(c, key, toString, "", defaultValue)
+}
 
-		return ""
-	}
+// GetInt retrieves an integer value with an optional default.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.port").
+func (c *Config) GetInt(key string, defaultValue ...int) int {
+	return getOrDefault(c, key, toInt, 0, defaultValue)
+}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+// GetInt32 retrieves an int32 value with an optional default.
+// Values outside the int32 range return the default.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.workers").
+func (c *Config) GetInt32(key string, defaultValue ...int32) int32 {
+	return getOrDefault(c, key, toInt32, 0, defaultValue)
+}
 
-	// Try flat key first
-	if value, exists := c.data[key]; exists {
-		if str, ok := value.(string); ok {
-			return str
-		}
+// GetInt64 retrieves an int64 value with an optional default.
+// Strings may hold decimal, hex (0x), octal (0o) or binary (0b) literals with
+// underscores between digits ("1_000_000").
+// Supports both flat keys ("key") and nested keys with dot notation ("server.id").
+func (c *Config) GetInt64(key string, defaultValue ...int64) int64 {
+	return getOrDefault(c, key, toInt64, 0, defaultValue)
+}
 
-		return fmt.Sprintf("%v", value)
-	}
+// GetUint retrieves a uint value with an optional default.
+// Negative values return the default.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.max_conns").
+func (c *Config) GetUint(key string, defaultValue ...uint) uint {
+	return getOrDefault(c, key, toUint, 0, defaultValue)
+}
 
-	// If flat key doesn't exist and key contains dots, try nested access
-	if strings.Contains(key, ".") {
-		if value, exists := c.getNestedValueUnsafe(key); exists {
-			if str, ok := value.(string); ok {
-				return str
-			}
+// GetUint64 retrieves a uint64 value with an optional default.
+// Negative values return the default, see GetInt64 for the accepted string literals.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.max_body").
+func (c *Config) GetUint64(key string, defaultValue ...uint64) uint64 {
+	return getOrDefault(c, key, toUint64, 0, defaultValue)
+}
 
-			return fmt.Sprintf("%v", value)
-		}
-	}
+// GetByteSize retrieves a size in bytes with an optional default.
+// It supports strings with SI units like "10MB" or "1.5G" (powers of 1000)
+// and IEC units like "512KiB" or "2Gi" (powers of 1024), and plain integers.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.max_body_size").
+func (c *Config) GetByteSize(key string, defaultValue ...uint64) uint64 {
+	return getOrDefault(c, key, toByteSize, 0, defaultValue)
+}
 
-	if len(defaultValue) > 0 {
-		return defaultValue[0]
-	}
+// GetFloat32 retrieves a float32 value with an optional default.
+// Values outside the float32 range return the default.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.ratio").
+func (c *Config) GetFloat32(key string, defaultValue ...float32) float32 {
+	return getOrDefault(c, key, toFloat32, 0, defaultValue)
+}
 
-	return ""
+// GetFloat64 retrieves a float64 value with an optional default.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.load").
+func (c *Config) GetFloat64(key string, defaultValue ...float64) float64 {
+	return getOrDefault(c, key, toFloat64, 0.0, defaultValue)
 }
 
-// GetInt retrieves an integer value with an optional default.
-// Supports both flat keys ("key") and nested keys with dot notation ("server.port").
-func (c *Config) GetInt(key string, defaultValue ...int) int {
+// GetBool retrieves a boolean value with an optional default.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.debug").
+func (c *Config) GetBool(key string, defaultValue ...bool) bool {
+	return getOrDefault(c, key, toBool, false, defaultValue)
+}
+
+// GetDuration retrieves a duration value with an optional default
+// It supports string representations like "30s" or "1m"
+// and also accepts integers and floats representing seconds.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.timeout").
+func (c *Config) GetDuration(key string, defaultValue ...time.Duration) time.Duration {
+	return getOrDefault(c, key, toDuration, 0, defaultValue)
+}
+
+// GetStringSlice retrieves a string slice value.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.features").
+func (c *Config) GetStringSlice(key string, defaultValue ...[]string) []string {
 	if c == nil {
 		if len(defaultValue) > 0 {
 			return defaultValue[0]
 		}
 
-		return 0
-	}
-
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	// Try flat key first
-	if value, exists := c.data[key]; exists {
-		switch v := value.(type) {
-		case int:
-			return v
-		case int64:
-			return int(v)
-		case float64:
-			return int(v)
-		case float32:
-			return int(v)
-		case string:
-			if parsed, err := strconv.Atoi(v); err == nil {
-				return parsed
-			}
-		}
+		return nil
 	}
 
-	// If flat key doesn't exist and key contains dots, try nested access
-	if strings.Contains(key, ".") {
-		if value, exists := c.getNestedValueUnsafe(key); exists {
-			switch v := value.(type) {
-			case int:
-				return v
-			case int64:
-				return int(v)
-			case float64:
-				return int(v)
-			case float32:
-				return int(v)
-			case string:
-				if parsed, err := strconv.Atoi(v); err == nil {
-					return parsed
-				}
-			}
-		}
-	}
+	return getOrDefault(c, key, toStringSlice, []string{}, defaultValue)
+}
 
-	if len(defaultValue) > 0 {
-		return defaultValue[0]
-	}
+// GetIntSlice retrieves an int slice value, converting each element like GetInt.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.ports").
+func (c *Config) GetIntSlice(key string, defaultValue ...[]int) []int {
+	return getOrDefault(c, key, toIntSlice, nil, defaultValue)
+}
 
-	return 0
+// GetFloat64Slice retrieves a float64 slice value, converting each element like GetFloat64.
+// Supports both flat keys ("key") and nested keys with dot notation ("balancer.weights").
+func (c *Config) GetFloat64Slice(key string, defaultValue ...[]float64) []float64 {
+	return getOrDefault(c, key, toFloat64Slice, nil, defaultValue)
 }
 
-// GetFloat64 retrieves a float64 value with an optional default.
-// Supports both flat keys ("key") and nested keys with dot notation ("server.load").
-func (c *Config) GetFloat64(key string, defaultValue ...float64) float64 {
-	if c == nil {
-		if len(defaultValue) > 0 {
-			return defaultValue[0]
-		}
+// GetBoolSlice retrieves a bool slice value, converting each element like GetBool.
+// Supports both flat keys ("key") and nested keys with dot notation ("features.flags").
+func (c *Config) GetBoolSlice(key string, defaultValue ...[]bool) []bool {
+	return getOrDefault(c, key, toBoolSlice, nil, defaultValue)
+}
 
-		return 0.0
-	}
+// GetDurationSlice retrieves a duration slice value, converting each element like GetDuration.
+// Supports both flat keys ("key") and nested keys with dot notation ("retry.backoff").
+func (c *Config) GetDurationSlice(key string, defaultValue ...[]time.Duration) []time.Duration {
+	return getOrDefault(c, key, toDurationSlice, nil, defaultValue)
+}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	// Try flat key first
-	if value, exists := c.data[key]; exists {
-		switch v := value.(type) {
-		case float64:
-			return v
-		case float32:
-			return float64(v)
-		case int:
-			return float64(v)
-		case int64:
-			return float64(v)
-		case string:
-			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
-				return parsed
-			}
-		}
-	}
+// GetStringMap retrieves a map value with string keys.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.headers").
+func (c *Config) GetStringMap(key string, defaultValue ...map[string]any) map[string]any {
+	return getOrDefault(c, key, toStringMap, nil, defaultValue)
+}
 
-	// If flat key doesn't exist and key contains dots, try nested access
-	if strings.Contains(key, ".") {
-		if value, exists := c.getNestedValueUnsafe(key); exists {
-			switch v := value.(type) {
-			case float64:
-				return v
-			case float32:
-				return float64(v)
-			case int:
-				return float64(v)
-			case int64:
-				return float64(v)
-			case string:
-				if parsed, err := strconv.ParseFloat(v, 64); err == nil {
-					return parsed
-				}
-			}
-		}
-	}
+// GetStringMapString retrieves a map of strings, converting each value like GetString.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.labels").
+func (c *Config) GetStringMapString(key string, defaultValue ...map[string]string) map[string]string {
+	return getOrDefault(c, key, toStringMapString, nil, defaultValue)
+}
 
-	if len(defaultValue) > 0 {
-		return defaultValue[0]
-	}
+// GetStringMapStringSlice retrieves a map of string slices, converting each value like GetStringSlice.
+// Supports both flat keys ("key") and nested keys with dot notation ("acl.groups").
+func (c *Config) GetStringMapStringSlice(key string, defaultValue ...map[string][]string) map[string][]string {
+	return getOrDefault(c, key, toStringMapStringSlice, nil, defaultValue)
+}
 
-	return 0.0
+// GetStringE retrieves a string value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value is a section or a list.
+func (c *Config) GetStringE(key string) (string, error) {
+	return getE(c, key, toScalarString)
 }
 
-// GetBool retrieves a boolean value with an optional default.
-// Supports both flat keys ("key") and nested keys with dot notation ("server.debug").
-func (c *Config) GetBool(key string, defaultValue ...bool) bool {
-	if c == nil {
-		if len(defaultValue) > 0 {
-			return defaultValue[0]
-		}
+// GetIntE retrieves an integer value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value cannot be converted.
+func (c *Config) GetIntE(key string) (int, error) {
+	return getE(c, key, toInt)
+}
 
-		return false
-	}
+// GetInt32E retrieves an int32 value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value cannot be converted or overflows.
+func (c *Config) GetInt32E(key string) (int32, error) {
+	return getE(c, key, toInt32)
+}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	// Try flat key first
-	if value, exists := c.data[key]; exists {
-		switch v := value.(type) {
-		case bool:
-			return v
-		case string:
-			if parsed, err := strconv.ParseBool(v); err == nil {
-				return parsed
-			}
-		}
-	}
+// GetInt64E retrieves an int64 value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value cannot be converted or overflows.
+func (c *Config) GetInt64E(key string) (int64, error) {
+	return getE(c, key, toInt64)
+}
 
-	// If flat key doesn't exist and key contains dots, try nested access
-	if strings.Contains(key, ".") {
-		if value, exists := c.getNestedValueUnsafe(key); exists {
-			switch v := value.(type) {
-			case bool:
-				return v
-			case string:
-				if parsed, err := strconv.ParseBool(v); err == nil {
-					return parsed
-				}
-			}
-		}
-	}
+// GetUintE retrieves a uint value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value cannot be converted, is negative or overflows.
+func (c *Config) GetUintE(key string) (uint, error) {
+	return getE(c, key, toUint)
+}
 
-	if len(defaultValue) > 0 {
-		return defaultValue[0]
-	}
+// GetUint64E retrieves a uint64 value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value cannot be converted, is negative or overflows.
+func (c *Config) GetUint64E(key string) (uint64, error) {
+	return getE(c, key, toUint64)
+}
 
-	return false
+// GetByteSizeE retrieves a size in bytes, see GetByteSize for the accepted formats.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value cannot be converted, is negative or overflows.
+func (c *Config) GetByteSizeE(key string) (uint64, error) {
+	return getE(c, key, toByteSize)
 }
 
-// GetDuration retrieves a duration value with an optional default
-// It supports string representations like "30s" or "1m"
-// and also accepts integers and floats representing seconds.
-// Supports both flat keys ("key") and nested keys with dot notation ("server.timeout").
-func (c *Config) GetDuration(key string, defaultValue ...time.Duration) time.Duration {
-	if c == nil {
-		if len(defaultValue) > 0 {
-			return defaultValue[0]
-		}
+// GetFloat32E retrieves a float32 value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value cannot be converted or overflows.
+func (c *Config) GetFloat32E(key string) (float32, error) {
+	return getE(c, key, toFloat32)
+}
 
-		return 0
-	}
+// GetFloat64E retrieves a float64 value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value cannot be converted.
+func (c *Config) GetFloat64E(key string) (float64, error) {
+	return getE(c, key, toFloat64)
+}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	// Try flat key first
-	if value, exists := c.data[key]; exists {
-		switch v := value.(type) {
-		case string:
-			if parsed, err := time.ParseDuration(v); err == nil {
-				return parsed
-			}
-
-			if seconds, err := strconv.Atoi(v); err == nil {
-				return time.Duration(seconds) * time.Second
-			}
-		case int:
-			return time.Duration(v) * time.Second
-		case int64:
-			return time.Duration(v) * time.Second
-		case float64:
-			return time.Duration(v * float64(time.Second))
-		}
-	}
+// GetBoolE retrieves a boolean value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value cannot be converted.
+func (c *Config) GetBoolE(key string) (bool, error) {
+	return getE(c, key, toBool)
+}
 
-	// If flat key doesn't exist and key contains dots, try nested access
-	if strings.Contains(key, ".") {
-		if value, exists := c.getNestedValueUnsafe(key); exists {
-			switch v := value.(type) {
-			case string:
-				if parsed, err := time.ParseDuration(v); err == nil {
-					return parsed
-				}
-
-				if seconds, err := strconv.Atoi(v); err == nil {
-					return time.Duration(seconds) * time.Second
-				}
-			case int:
-				return time.Duration(v) * time.Second
-			case int64:
-				return time.Duration(v) * time.Second
-			case float64:
-				return time.Duration(v * float64(time.Second))
-			}
-		}
-	}
+// GetDurationE retrieves a duration value, see GetDuration for the accepted formats.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value cannot be converted.
+func (c *Config) GetDurationE(key string) (time.Duration, error) {
+	return getE(c, key, toDuration)
+}
 
-	if len(defaultValue) > 0 {
-		return defaultValue[0]
-	}
+// GetStringSliceE retrieves a string slice value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value cannot be converted.
+func (c *Config) GetStringSliceE(key string) ([]string, error) {
+	return getE(c, key, toStringSlice)
+}
 
-	return 0
+// GetIntSliceE retrieves an int slice value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch naming the index of the first element that cannot be converted.
+func (c *Config) GetIntSliceE(key string) ([]int, error) {
+	return getE(c, key, toIntSlice)
 }
 
-// GetStringSlice retrieves a string slice value.
-// Supports both flat keys ("key") and nested keys with dot notation ("server.features").
-func (c *Config) GetStringSlice(key string, defaultValue ...[]string) []string {
-	if c == nil {
-		if len(defaultValue) > 0 {
-			return defaultValue[0]
-		}
+// GetFloat64SliceE retrieves a float64 slice value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch naming the index of the first element that cannot be converted.
+func (c *Config) GetFloat64SliceE(key string) ([]float64, error) {
+	return getE(c, key, toFloat64Slice)
+}
 
-		return nil
-	}
+// GetBoolSliceE retrieves a bool slice value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch naming the index of the first element that cannot be converted.
+func (c *Config) GetBoolSliceE(key string) ([]bool, error) {
+	return getE(c, key, toBoolSlice)
+}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	// Try flat key first
-	if value, exists := c.data[key]; exists {
-		switch v := value.(type) {
-		case []string:
-			return v
-		case []any:
-			result := make([]string, len(v))
-			for i, item := range v {
-				result[i] = fmt.Sprintf("%v", item)
-			}
-
-			return result
-		case string:
-			return strings.Split(v, ",")
-		}
-	}
+// GetDurationSliceE retrieves a duration slice value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch naming the index of the first element that cannot be converted.
+func (c *Config) GetDurationSliceE(key string) ([]time.Duration, error) {
+	return getE(c, key, toDurationSlice)
+}
 
-	// If flat key doesn't exist and key contains dots, try nested access
-	if strings.Contains(key, ".") {
-		if value, exists := c.getNestedValueUnsafe(key); exists {
-			switch v := value.(type) {
-			case []string:
-				return v
-			case []any:
-				result := make([]string, len(v))
-				for i, item := range v {
-					result[i] = fmt.Sprintf("%v", item)
-				}
-
-				return result
-			case string:
-				return strings.Split(v, ",")
-			}
-		}
-	}
+// GetStringMapE retrieves a map value with string keys.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value is not a map.
+func (c *Config) GetStringMapE(key string) (map[string]any, error) {
+	return getE(c, key, toStringMap)
+}
 
-	if len(defaultValue) > 0 {
-		return defaultValue[0]
-	}
+// GetStringMapStringE retrieves a map of strings.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch naming the first key whose value cannot be converted.
+func (c *Config) GetStringMapStringE(key string) (map[string]string, error) {
+	return getE(c, key, toStringMapString)
+}
 
-	return []string{}
+// GetStringMapStringSliceE retrieves a map of string slices.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch naming the first key whose value cannot be converted.
+func (c *Config) GetStringMapStringSliceE(key string) (map[string][]string, error) {
+	return getE(c, key, toStringMapStringSlice)
 }
 
 // GetNestedMap returns a nested map at the specified path.
@@ -359,42 +296,51 @@ func (c *Config) GetNestedMap(key string) map[string]any {
 		return nil
 	}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	c.locker().RLock()
+	defer c.locker().RUnlock()
 
 	if value, exists := c.getNestedValueUnsafe(key); exists {
 		if nestedMap, ok := value.(map[string]any); ok {
-			// Return a copy to maintain thread safety
-			result := make(map[string]any)
-			for k, v := range nestedMap {
-				result[k] = v
-			}
-
-			return result
+			// Return a deep copy to maintain thread safety
+			return deepCopyMap(nestedMap)
 		}
 	}
 
 	return nil
 }
 
-// GetNestedKeys returns all keys that start with the given prefix.
-// For example, GetNestedKeys("server") might return ["server.host", "server.port", "server.debug"].
+// GetNestedKeys returns the keys of the direct children of the map or list at prefix.
+// For example, GetNestedKeys("server") might return ["server.debug", "server.host", "server.port"],
+// and GetNestedKeys("server.upstreams") ["server.upstreams[0]", "server.upstreams[1]"].
+// Prefix may be nested to any depth; keys are sorted and escaped so they can be passed back to the getters.
 func (c *Config) GetNestedKeys(prefix string) []string {
 	if c == nil {
 		return nil
 	}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	c.locker().RLock()
+	defer c.locker().RUnlock()
+
+	value, exists := c.lookupUnsafe(prefix)
+	if !exists {
+		return nil
+	}
 
 	var keys []string
 
-	// Check if prefix exists as a map
-	if value, exists := c.data[prefix]; exists {
-		if nestedMap, ok := value.(map[string]any); ok {
-			for key := range nestedMap {
-				keys = append(keys, prefix+"."+key)
-			}
+	delimiter := c.delimiter()
+
+	if nestedMap, ok := value.(map[string]any); ok {
+		for _, key := range slices.Sorted(maps.Keys(nestedMap)) {
+			keys = append(keys, joinKeyPath(prefix, escapeKeyPart(key, delimiter), delimiter))
+		}
+
+		return keys
+	}
+
+	if list := reflect.ValueOf(value); list.Kind() == reflect.Slice {
+		for i := range list.Len() {
+			keys = append(keys, indexKeyPath(prefix, i))
 		}
 	}
 
@@ -407,11 +353,48 @@ func (c *Config) GetAll() map[string]any {
 		return nil
 	}
 
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	c.locker().RLock()
+	defer c.locker().RUnlock()
+
+	return deepCopyMap(c.dataUnsafe())
+}
+
+// getE retrieves the value at key converted with convert.
+// Missing keys and conversion failures are reported as errors.
+func getE[T any](c *Config, key string, convert func(any) (T, error)) (T, error) {
+	var zero T
+
+	if c == nil {
+		return zero, ErrConfigNil
+	}
+
+	c.locker().RLock()
+	defer c.locker().RUnlock()
+
+	value, exists := c.lookupUnsafe(key)
+	if !exists {
+		return zero, fmt.Errorf("%w: %q", ErrKeyNotFound, key)
+	}
+
+	converted, err := convert(value)
+	if err != nil {
+		return zero, typeMismatchError(key, value, err)
+	}
+
+	return converted, nil
+}
+
+// getOrDefault retrieves the value at key converted with convert.
+// If the key is missing or cannot be converted, the first default value
+// is returned, or fallback if no default was given.
+func getOrDefault[T any](c *Config, key string, convert func(any) (T, error), fallback T, defaultValue []T) T {
+	if converted, err := getE(c, key, convert); err == nil {
+		return converted
+	}
 
-	result := make(map[string]any, len(c.data))
-	maps.Copy(result, c.data)
+	if len(defaultValue) > 0 {
+		return defaultValue[0]
+	}
 
-	return result
+	return fallback
 }
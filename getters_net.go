@@ -0,0 +1,255 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | getters_net.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// builtinTimeLayouts are tried by GetTime after the configured layouts.
+var builtinTimeLayouts = []string{time.RFC3339Nano, time.DateTime, time.DateOnly}
+
+// GetTime retrieves a time value with an optional default.
+// Strings are parsed with the layouts added by WithTimeLayouts, then as RFC 3339,
+// "2006-01-02 15:04:05" and "2006-01-02"; integers are Unix timestamps in seconds.
+// Supports both flat keys ("key") and nested keys with dot notation ("release.cutoff").
+func (c *Config) GetTime(key string, defaultValue ...time.Time) time.Time {
+	return getOrDefault(c, key, c.toTime, time.Time{}, defaultValue)
+}
+
+// GetTimeE retrieves a time value, see GetTime for the accepted formats.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value cannot be converted.
+func (c *Config) GetTimeE(key string) (time.Time, error) {
+	return getE(c, key, c.toTime)
+}
+
+// GetURL retrieves a URL value with an optional default.
+// Supports both flat keys ("key") and nested keys with dot notation ("upstream.endpoint").
+func (c *Config) GetURL(key string, defaultValue ...*url.URL) *url.URL {
+	return getOrDefault(c, key, toURL, nil, defaultValue)
+}
+
+// GetURLE retrieves a URL value.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value is not a valid URL.
+func (c *Config) GetURLE(key string) (*url.URL, error) {
+	return getE(c, key, toURL)
+}
+
+// GetIP retrieves an IP address like "10.0.0.1" or "::1" with an optional default.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.bind_ip").
+func (c *Config) GetIP(key string, defaultValue ...netip.Addr) netip.Addr {
+	return getOrDefault(c, key, toIP, netip.Addr{}, defaultValue)
+}
+
+// GetIPE retrieves an IP address.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value is not a valid IP address.
+func (c *Config) GetIPE(key string) (netip.Addr, error) {
+	return getE(c, key, toIP)
+}
+
+// GetIPNet retrieves a network in CIDR notation like "10.0.0.0/8" with an optional default.
+// Supports both flat keys ("key") and nested keys with dot notation ("firewall.allow").
+func (c *Config) GetIPNet(key string, defaultValue ...netip.Prefix) netip.Prefix {
+	return getOrDefault(c, key, toIPNet, netip.Prefix{}, defaultValue)
+}
+
+// GetIPNetE retrieves a network in CIDR notation.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value is not a valid CIDR.
+func (c *Config) GetIPNetE(key string) (netip.Prefix, error) {
+	return getE(c, key, toIPNet)
+}
+
+// GetAddrPort retrieves an address with port like "127.0.0.1:8080" or "[::1]:80" with an optional default.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.listen").
+func (c *Config) GetAddrPort(key string, defaultValue ...netip.AddrPort) netip.AddrPort {
+	return getOrDefault(c, key, toAddrPort, netip.AddrPort{}, defaultValue)
+}
+
+// GetAddrPortE retrieves an address with port.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the value is not a valid address with port.
+func (c *Config) GetAddrPortE(key string) (netip.AddrPort, error) {
+	return getE(c, key, toAddrPort)
+}
+
+// GetRegexp retrieves a compiled regular expression with an optional default.
+// Compiled expressions are cached per key and recompiled only when the pattern changes.
+// Supports both flat keys ("key") and nested keys with dot notation ("routes.allow").
+func (c *Config) GetRegexp(key string, defaultValue ...*regexp.Regexp) *regexp.Regexp {
+	return getOrDefault(c, key, c.regexpConverter(key), nil, defaultValue)
+}
+
+// GetRegexpE retrieves a compiled regular expression, see GetRegexp.
+// Returns an error wrapping ErrKeyNotFound if the key does not exist,
+// or ErrTypeMismatch if the pattern does not compile.
+func (c *Config) GetRegexpE(key string) (*regexp.Regexp, error) {
+	return getE(c, key, c.regexpConverter(key))
+}
+
+// toTime converts a configuration value to a time.Time.
+func (c *Config) toTime(value any) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		for _, layouts := range [][]string{c.timeLayouts, builtinTimeLayouts} {
+			for _, layout := range layouts {
+				if parsed, err := time.Parse(layout, v); err == nil {
+					return parsed, nil
+				}
+			}
+		}
+
+		return time.Time{}, fmt.Errorf("cannot parse %q as time", v)
+	}
+
+	seconds, err := toInt64(value)
+	if err != nil {
+		return time.Time{}, errors.New("cannot convert to time")
+	}
+
+	return time.Unix(seconds, 0).UTC(), nil
+}
+
+// regexpConverter returns a converter compiling patterns stored at key, using the cache.
+func (c *Config) regexpConverter(key string) func(any) (*regexp.Regexp, error) {
+	return func(value any) (*regexp.Regexp, error) {
+		switch v := value.(type) {
+		case *regexp.Regexp:
+			return v, nil
+		case string:
+			return c.compileCachedRegexp(key, v)
+		default:
+			return nil, errors.New("cannot convert to regular expression")
+		}
+	}
+}
+
+// compileCachedRegexp compiles pattern, reusing the expression cached for key if the pattern is unchanged.
+func (c *Config) compileCachedRegexp(key, pattern string) (*regexp.Regexp, error) {
+	c.regexpMu.Lock()
+	defer c.regexpMu.Unlock()
+
+	if cached, exists := c.regexpCache[key]; exists && cached.String() == pattern {
+		return cached, nil
+	}
+
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compile %q: %w", pattern, err)
+	}
+
+	if c.regexpCache == nil {
+		c.regexpCache = make(map[string]*regexp.Regexp)
+	}
+
+	c.regexpCache[key] = compiled
+
+	return compiled, nil
+}
+
+// toURL converts a configuration value to a URL.
+func toURL(value any) (*url.URL, error) {
+	switch v := value.(type) {
+	case *url.URL:
+		copied := *v
+
+		return &copied, nil
+	case url.URL:
+		return &v, nil
+	case string:
+		if v == "" {
+			return nil, errors.New("empty URL")
+		}
+
+		parsed, err := url.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %q as URL", v)
+		}
+
+		return parsed, nil
+	default:
+		return nil, errors.New("cannot convert to URL")
+	}
+}
+
+// toIP converts a configuration value to an IP address.
+func toIP(value any) (netip.Addr, error) {
+	switch v := value.(type) {
+	case netip.Addr:
+		return v, nil
+	case net.IP:
+		if addr, ok := netip.AddrFromSlice(v); ok {
+			return addr.Unmap(), nil
+		}
+
+		return netip.Addr{}, errors.New("invalid IP address")
+	case string:
+		addr, err := netip.ParseAddr(v)
+		if err != nil {
+			return netip.Addr{}, fmt.Errorf("cannot parse %q as IP address", v)
+		}
+
+		return addr, nil
+	default:
+		return netip.Addr{}, errors.New("cannot convert to IP address")
+	}
+}
+
+// toIPNet converts a configuration value to a network prefix.
+func toIPNet(value any) (netip.Prefix, error) {
+	switch v := value.(type) {
+	case netip.Prefix:
+		return v, nil
+	case string:
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("cannot parse %q as CIDR", v)
+		}
+
+		return prefix, nil
+	default:
+		return netip.Prefix{}, errors.New("cannot convert to CIDR")
+	}
+}
+
+// toAddrPort converts a configuration value to an address with port.
+func toAddrPort(value any) (netip.AddrPort, error) {
+	switch v := value.(type) {
+	case netip.AddrPort:
+		return v, nil
+	case string:
+		addrPort, err := netip.ParseAddrPort(v)
+		if err != nil {
+			return netip.AddrPort{}, fmt.Errorf("cannot parse %q as address with port", v)
+		}
+
+		return addrPort, nil
+	default:
+		return netip.AddrPort{}, errors.New("cannot convert to address with port")
+	}
+}
@@ -0,0 +1,304 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | getters_net_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"net/netip"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetters_Time tests GetTime with built-in and configured layouts
+func TestGetters_Time(t *testing.T) {
+	c, err := New(WithTimeLayouts("02.01.2006"))
+	require.NoError(t, err)
+
+	native := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	c.LoadFromMap(map[string]any{
+		"release": map[string]any{
+			"rfc3339":  "2026-10-16T12:30:00+02:00",
+			"nano":     "2026-10-16T12:30:00.123456789Z",
+			"datetime": "2026-10-16 12:30:00",
+			"date":     "2026-10-16",
+			"custom":   "16.10.2026",
+			"unix":     int64(1700000000),
+			"native":   native,
+			"invalid":  "someday",
+			"flag":     true,
+		},
+		"flat.cutoff": "2026-01-01",
+	})
+
+	t.Run("built-in layouts", func(t *testing.T) {
+		assert.True(t, c.GetTime("release.rfc3339").Equal(time.Date(2026, 10, 16, 10, 30, 0, 0, time.UTC)))
+		assert.Equal(t, 123456789, c.GetTime("release.nano").Nanosecond())
+		assert.Equal(t, time.Date(2026, 10, 16, 12, 30, 0, 0, time.UTC), c.GetTime("release.datetime"))
+		assert.Equal(t, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), c.GetTime("release.date"))
+		assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), c.GetTime("flat.cutoff"))
+	})
+
+	t.Run("configured layouts", func(t *testing.T) {
+		assert.Equal(t, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), c.GetTime("release.custom"))
+
+		plain, err := New()
+		require.NoError(t, err)
+		plain.Set("custom", "16.10.2026")
+
+		_, err = plain.GetTimeE("custom")
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+	})
+
+	t.Run("non-string values", func(t *testing.T) {
+		assert.Equal(t, time.Unix(1700000000, 0).UTC(), c.GetTime("release.unix"))
+		assert.Equal(t, native, c.GetTime("release.native"))
+	})
+
+	t.Run("defaults and errors", func(t *testing.T) {
+		assert.Equal(t, native, c.GetTime("release.invalid", native))
+		assert.True(t, c.GetTime("missing").IsZero())
+
+		_, err := c.GetTimeE("release.invalid")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), `"someday"`)
+
+		_, err = c.GetTimeE("release.flag")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+
+		_, err = c.GetTimeE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
+
+// TestGetters_URL tests GetURL and GetURLE
+func TestGetters_URL(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"upstream": map[string]any{
+			"endpoint": "https://api.example.com:8443/v1?debug=true",
+			"invalid":  "http://[::1",
+			"empty":    "",
+			"port":     8080,
+		},
+	})
+
+	endpoint := c.GetURL("upstream.endpoint")
+	require.NotNil(t, endpoint)
+	assert.Equal(t, "https", endpoint.Scheme)
+	assert.Equal(t, "api.example.com", endpoint.Hostname())
+	assert.Equal(t, "8443", endpoint.Port())
+	assert.Equal(t, "/v1", endpoint.Path)
+
+	// Each call returns an independent URL
+	endpoint.Path = "/changed"
+	assert.Equal(t, "/v1", c.GetURL("upstream.endpoint").Path)
+
+	fallback := &url.URL{Scheme: "http", Host: "localhost"}
+	assert.Equal(t, fallback, c.GetURL("upstream.invalid", fallback))
+	assert.Nil(t, c.GetURL("missing"))
+
+	for _, key := range []string{"upstream.invalid", "upstream.empty", "upstream.port"} {
+		_, err = c.GetURLE(key)
+		require.ErrorIs(t, err, ErrTypeMismatch, key)
+	}
+
+	_, err = c.GetURLE("missing")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+// TestGetters_NetworkAddresses tests GetIP, GetIPNet and GetAddrPort
+func TestGetters_NetworkAddresses(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"server": map[string]any{
+			"bind_ip":   "10.0.0.1",
+			"bind_ipv6": "::1",
+			"listen":    "127.0.0.1:8080",
+			"listen_v6": "[::1]:443",
+		},
+		"firewall": map[string]any{
+			"allow":   "10.0.0.0/8",
+			"allow6":  "2001:db8::/32",
+			"invalid": "10.0.0.0/33",
+		},
+		"ip.flat": "192.168.1.1",
+		"port":    8080,
+	})
+
+	t.Run("GetIP", func(t *testing.T) {
+		assert.Equal(t, netip.MustParseAddr("10.0.0.1"), c.GetIP("server.bind_ip"))
+		assert.Equal(t, netip.IPv6Loopback(), c.GetIP("server.bind_ipv6"))
+		assert.Equal(t, netip.MustParseAddr("192.168.1.1"), c.GetIP("ip.flat"))
+		assert.Equal(t, netip.IPv4Unspecified(), c.GetIP("server.listen", netip.IPv4Unspecified()))
+		assert.False(t, c.GetIP("missing").IsValid())
+
+		_, err := c.GetIPE("server.listen")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		_, err = c.GetIPE("port")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		_, err = c.GetIPE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("GetIPNet", func(t *testing.T) {
+		allow := c.GetIPNet("firewall.allow")
+		assert.Equal(t, netip.MustParsePrefix("10.0.0.0/8"), allow)
+		assert.True(t, allow.Contains(netip.MustParseAddr("10.1.2.3")))
+		assert.Equal(t, 32, c.GetIPNet("firewall.allow6").Bits())
+		assert.False(t, c.GetIPNet("firewall.invalid").IsValid())
+
+		_, err := c.GetIPNetE("firewall.invalid")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		_, err = c.GetIPNetE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("GetAddrPort", func(t *testing.T) {
+		listen := c.GetAddrPort("server.listen")
+		assert.Equal(t, netip.MustParseAddr("127.0.0.1"), listen.Addr())
+		assert.Equal(t, uint16(8080), listen.Port())
+		assert.Equal(t, uint16(443), c.GetAddrPort("server.listen_v6").Port())
+
+		fallback := netip.MustParseAddrPort("0.0.0.0:80")
+		assert.Equal(t, fallback, c.GetAddrPort("server.bind_ip", fallback))
+
+		_, err := c.GetAddrPortE("server.bind_ip")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		_, err = c.GetAddrPortE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
+
+// TestGetters_Regexp tests GetRegexp compilation and caching
+func TestGetters_Regexp(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"routes": map[string]any{
+			"allow":   `^/api/v[0-9]+/`,
+			"invalid": `([a-z`,
+		},
+	})
+
+	t.Run("compiles patterns", func(t *testing.T) {
+		re := c.GetRegexp("routes.allow")
+		require.NotNil(t, re)
+		assert.True(t, re.MatchString("/api/v2/users"))
+		assert.False(t, re.MatchString("/admin"))
+	})
+
+	t.Run("caches per key", func(t *testing.T) {
+		first := c.GetRegexp("routes.allow")
+		assert.Same(t, first, c.GetRegexp("routes.allow"))
+
+		c.Set("routes.allow", `^/v2/`)
+
+		changed := c.GetRegexp("routes.allow")
+		assert.NotSame(t, first, changed)
+		assert.Equal(t, `^/v2/`, changed.String())
+	})
+
+	t.Run("defaults and errors", func(t *testing.T) {
+		fallback := regexp.MustCompile(`.*`)
+		assert.Same(t, fallback, c.GetRegexp("routes.invalid", fallback))
+		assert.Nil(t, c.GetRegexp("missing"))
+
+		_, err := c.GetRegexpE("routes.invalid")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), "cannot compile")
+
+		_, err = c.GetRegexpE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
+
+// TestUnmarshal_NetworkTypes tests decoding of the types served by getters_net.go
+func TestUnmarshal_NetworkTypes(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"endpoint": "https://example.com/health",
+		"fallback": "http://localhost",
+		"bind":     "10.0.0.1",
+		"subnet":   "10.0.0.0/24",
+		"listen":   "127.0.0.1:9000",
+		"started":  "2026-10-16T08:00:00Z",
+		"filter":   `^user-\d+$`,
+	})
+
+	var target struct {
+		Endpoint url.URL        `config:"endpoint"`
+		Fallback *url.URL       `config:"fallback"`
+		Bind     netip.Addr     `config:"bind"`
+		Subnet   netip.Prefix   `config:"subnet"`
+		Listen   netip.AddrPort `config:"listen"`
+		Started  time.Time      `config:"started"`
+		Filter   *regexp.Regexp `config:"filter"`
+	}
+
+	require.NoError(t, c.Unmarshal(&target))
+	assert.Equal(t, "example.com", target.Endpoint.Host)
+	require.NotNil(t, target.Fallback)
+	assert.Equal(t, "localhost", target.Fallback.Host)
+	assert.Equal(t, netip.MustParseAddr("10.0.0.1"), target.Bind)
+	assert.Equal(t, netip.MustParsePrefix("10.0.0.0/24"), target.Subnet)
+	assert.Equal(t, uint16(9000), target.Listen.Port())
+	assert.Equal(t, time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC), target.Started)
+	require.NotNil(t, target.Filter)
+	assert.True(t, target.Filter.MatchString("user-42"))
+}
+
+// Benchmark Tests for getters_net.go functions
+
+func BenchmarkConfig_GetTime(b *testing.B) {
+	c, err := New()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	c.Set("release.date", "2026-10-16T12:30:00Z")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.GetTime("release.date")
+	}
+}
+
+func BenchmarkConfig_GetRegexp(b *testing.B) {
+	c, err := New()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	c.Set("routes.allow", `^/api/v[0-9]+/`)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.GetRegexp("routes.allow")
+	}
+}
@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | getters_test.go
 	::  ::          ::  ::    Created  | 2025-08-19
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -17,6 +17,7 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -226,6 +227,10 @@ func TestGetters_Comprehensive(t *testing.T) {
 		c.Set("single_item", "single")
 		assert.Equal(t, []string{"single"}, c.GetStringSlice("single_item"))
 
+		// Test spaces around comma-separated items are trimmed
+		c.Set("spaced_items", " red , green,blue ")
+		assert.Equal(t, []string{"red", "green", "blue"}, c.GetStringSlice("spaced_items"))
+
 		// Test empty string
 		assert.Equal(t, []string{""}, c.GetStringSlice("empty_string"))
 
@@ -374,6 +379,14 @@ func TestGetters_GetNestedKeys_EdgeCases(t *testing.T) {
 	assert.Contains(t, keys, "level1.level2a")
 	assert.Contains(t, keys, "level1.level2b")
 	assert.Len(t, keys, 2) // Both level2a and level2b are immediate children
+
+	// Nested prefixes and lists
+	c.Set("level1.level2a.list", []any{"a", map[string]any{"x": 1}})
+	assert.Equal(t, []string{"level1.level2a.level3", "level1.level2a.list"}, c.GetNestedKeys("level1.level2a"))
+	assert.Equal(t, []string{"level1.level2a.list[0]", "level1.level2a.list[1]"}, c.GetNestedKeys("level1.level2a.list"))
+	assert.Equal(t, []string{"level1.level2a.list[1].x"}, c.GetNestedKeys("level1.level2a.list[1]"))
+	assert.Empty(t, c.GetNestedKeys("level1.level2b"))
+	assert.Empty(t, c.GetNestedKeys("level1.missing"))
 }
 
 // TestGetters_GetAll_EdgeCases tests edge cases for GetAll
@@ -414,6 +427,341 @@ func TestGetters_TypeConversion_Errors(t *testing.T) {
 	assert.Equal(t, "<nil>", str) // GetString with nil returns "<nil>" due to fmt.Sprintf
 }
 
+// TestGetters_ErrorVariants tests the error-returning getter variants
+func TestGetters_ErrorVariants(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"name": "app",
+		"server": map[string]any{
+			"port":     "8080",
+			"bad_port": "80a",
+			"load":     0.75,
+			"debug":    "true",
+			"timeout":  "30s",
+			"hosts":    []any{"a", "b"},
+			"limits":   map[string]any{"rps": 10},
+		},
+	})
+
+	t.Run("ValidValues", func(t *testing.T) {
+		name, err := c.GetStringE("name")
+		require.NoError(t, err)
+		assert.Equal(t, "app", name)
+
+		port, err := c.GetIntE("server.port")
+		require.NoError(t, err)
+		assert.Equal(t, 8080, port)
+
+		load, err := c.GetFloat64E("server.load")
+		require.NoError(t, err)
+		assert.Equal(t, 0.75, load)
+
+		debug, err := c.GetBoolE("server.debug")
+		require.NoError(t, err)
+		assert.True(t, debug)
+
+		timeout, err := c.GetDurationE("server.timeout")
+		require.NoError(t, err)
+		assert.Equal(t, 30*time.Second, timeout)
+
+		hosts, err := c.GetStringSliceE("server.hosts")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, hosts)
+	})
+
+	t.Run("KeyNotFound", func(t *testing.T) {
+		_, err := c.GetStringE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+
+		_, err = c.GetIntE("server.missing")
+		require.ErrorIs(t, err, ErrKeyNotFound)
+		assert.Contains(t, err.Error(), `"server.missing"`)
+
+		_, err = c.GetFloat64E("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+
+		_, err = c.GetBoolE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+
+		_, err = c.GetDurationE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+
+		_, err = c.GetStringSliceE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("TypeMismatch", func(t *testing.T) {
+		port, err := c.GetIntE("server.bad_port")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.NotErrorIs(t, err, ErrKeyNotFound)
+		assert.Equal(t, 0, port)
+		assert.Contains(t, err.Error(), `"server.bad_port"`)
+		assert.Contains(t, err.Error(), "string value")
+
+		_, err = c.GetFloat64E("server.debug")
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+
+		_, err = c.GetBoolE("server.load")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), "float64 value")
+
+		_, err = c.GetDurationE("server.debug")
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+
+		_, err = c.GetStringSliceE("server.limits")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), "map[string]interface {} value")
+
+		// Sections and lists are not strings
+		section, err := c.GetStringE("server")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Empty(t, section)
+		assert.Contains(t, err.Error(), `"server"`)
+
+		_, err = c.GetStringE("server.hosts")
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+
+		// The default-returning getter still falls back silently
+		assert.Equal(t, 80, c.GetInt("server.bad_port", 80))
+	})
+
+	t.Run("NilConfig", func(t *testing.T) {
+		var nilConfig *Config
+
+		_, err := nilConfig.GetStringE("any")
+		assert.ErrorIs(t, err, ErrConfigNil)
+
+		_, err = nilConfig.GetIntE("any")
+		assert.ErrorIs(t, err, ErrConfigNil)
+	})
+}
+
+// TestGetters_FullRangeNumeric tests the sized and unsigned numeric getters
+func TestGetters_FullRangeNumeric(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"limits": map[string]any{
+			"snowflake":   uint64(18446744073709551615),
+			"big":         int64(9007199254740993),
+			"hex":         "0xFF",
+			"underscored": "1_000_000",
+			"negative":    -1,
+			"json":        json.Number("42"),
+			"ratio":       "0.5",
+			"huge":        1e40,
+			"workers":     int64(3000000000),
+		},
+	})
+
+	t.Run("Int64", func(t *testing.T) {
+		assert.Equal(t, int64(9007199254740993), c.GetInt64("limits.big"))
+		assert.Equal(t, int64(255), c.GetInt64("limits.hex"))
+		assert.Equal(t, int64(1000000), c.GetInt64("limits.underscored"))
+		assert.Equal(t, int64(42), c.GetInt64("limits.json"))
+		assert.Equal(t, int64(7), c.GetInt64("limits.snowflake", 7))
+		assert.Equal(t, int64(0), c.GetInt64("missing"))
+
+		_, err := c.GetInt64E("limits.snowflake")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), "overflows int64")
+	})
+
+	t.Run("Int32", func(t *testing.T) {
+		assert.Equal(t, int32(-1), c.GetInt32("limits.negative"))
+		assert.Equal(t, int32(5), c.GetInt32("limits.workers", 5))
+
+		_, err := c.GetInt32E("limits.workers")
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+	})
+
+	t.Run("Uint", func(t *testing.T) {
+		assert.Equal(t, uint(255), c.GetUint("limits.hex"))
+		assert.Equal(t, uint(9), c.GetUint("limits.negative", 9))
+
+		_, err := c.GetUintE("limits.negative")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), "negative")
+	})
+
+	t.Run("Uint64", func(t *testing.T) {
+		assert.Equal(t, uint64(18446744073709551615), c.GetUint64("limits.snowflake"))
+		assert.Equal(t, uint64(1000000), c.GetUint64("limits.underscored"))
+		assert.Equal(t, uint64(42), c.GetUint64("limits.json"))
+
+		_, err := c.GetUint64E("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("Float32", func(t *testing.T) {
+		assert.Equal(t, float32(0.5), c.GetFloat32("limits.ratio"))
+		assert.Equal(t, float32(1.5), c.GetFloat32("limits.huge", 1.5))
+
+		_, err := c.GetFloat32E("limits.huge")
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+	})
+
+	t.Run("IntOverflow", func(t *testing.T) {
+		// GetInt no longer truncates silently
+		assert.Equal(t, 1, c.GetInt("limits.snowflake", 1))
+		assert.Equal(t, 255, c.GetInt("limits.hex"))
+	})
+
+	t.Run("NilConfig", func(t *testing.T) {
+		var nilConfig *Config
+
+		assert.Equal(t, int64(3), nilConfig.GetInt64("any", 3))
+		assert.Equal(t, uint64(0), nilConfig.GetUint64("any"))
+	})
+}
+
+// TestGetters_GetByteSize tests the human-readable byte size getter
+func TestGetters_GetByteSize(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"http": map[string]any{
+			"max_body_size": "10MB",
+			"cache_size":    "512KiB",
+			"rotate_size":   1048576,
+			"invalid":       "lots",
+		},
+	})
+
+	assert.Equal(t, uint64(10_000_000), c.GetByteSize("http.max_body_size"))
+	assert.Equal(t, uint64(512*1024), c.GetByteSize("http.cache_size"))
+	assert.Equal(t, uint64(1048576), c.GetByteSize("http.rotate_size"))
+	assert.Equal(t, uint64(1024), c.GetByteSize("http.invalid", 1024))
+	assert.Equal(t, uint64(0), c.GetByteSize("missing"))
+
+	size, err := c.GetByteSizeE("http.cache_size")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(524288), size)
+
+	_, err = c.GetByteSizeE("http.invalid")
+	require.ErrorIs(t, err, ErrTypeMismatch)
+	assert.Contains(t, err.Error(), `"http.invalid"`)
+
+	_, err = c.GetByteSizeE("missing")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+// TestGetters_TypedCollections tests typed slice and map getters
+func TestGetters_TypedCollections(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"server": map[string]any{
+			"ports":   []any{80, "443", 8080.0},
+			"bad":     []any{80, "http", 8080},
+			"weights": []any{0.5, 1, "2.5"},
+			"flags":   "true, false, 1",
+			"backoff": []any{"100ms", 2, "1m"},
+			"headers": map[string]any{"X-Trace": true, "X-Retries": 3},
+			"labels":  map[string]any{"tier": "web", "replicas": 3},
+			"groups":  map[string]any{"admins": []any{"alice"}, "users": "bob,carol"},
+		},
+		"flat.ports": []int{1, 2},
+	})
+
+	t.Run("slices", func(t *testing.T) {
+		assert.Equal(t, []int{80, 443, 8080}, c.GetIntSlice("server.ports"))
+		assert.Equal(t, []int{1, 2}, c.GetIntSlice("flat.ports"))
+		assert.Equal(t, []float64{0.5, 1, 2.5}, c.GetFloat64Slice("server.weights"))
+		assert.Equal(t, []bool{true, false, true}, c.GetBoolSlice("server.flags"))
+		assert.Equal(t, []time.Duration{100 * time.Millisecond, 2 * time.Second, time.Minute},
+			c.GetDurationSlice("server.backoff"))
+	})
+
+	t.Run("maps", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"X-Trace": true, "X-Retries": 3}, c.GetStringMap("server.headers"))
+		assert.Equal(t, map[string]string{"tier": "web", "replicas": "3"}, c.GetStringMapString("server.labels"))
+		assert.Equal(t, map[string][]string{"admins": {"alice"}, "users": {"bob", "carol"}},
+			c.GetStringMapStringSlice("server.groups"))
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		assert.Equal(t, []int{1}, c.GetIntSlice("server.bad", []int{1}))
+		assert.Nil(t, c.GetIntSlice("missing"))
+		assert.Nil(t, c.GetStringMap("server.ports"))
+		assert.Equal(t, map[string]string{"a": "b"}, c.GetStringMapString("missing", map[string]string{"a": "b"}))
+	})
+
+	t.Run("errors report the bad element", func(t *testing.T) {
+		_, err := c.GetIntSliceE("server.bad")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), `"server.bad"`)
+		assert.Contains(t, err.Error(), `element 1: cannot parse "http" as integer`)
+
+		_, err = c.GetBoolSliceE("server.weights")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), "element 0")
+
+		_, err = c.GetStringMapStringSliceE("server.labels")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), `key "replicas"`)
+
+		_, err = c.GetFloat64SliceE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+
+		_, err = c.GetDurationSliceE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+
+		_, err = c.GetStringMapE("server.flags")
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+
+		_, err = c.GetStringMapStringE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
+
+// TestGetters_ReturnCopies tests that values handed out by the getters are not shared
+func TestGetters_ReturnCopies(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"tags": []string{"a", "b"},
+		"server": map[string]any{
+			"hosts":  []any{"h1", "h2"},
+			"labels": map[string]any{"env": "prod"},
+		},
+	})
+
+	tags := c.GetStringSlice("tags")
+	tags[0] = "changed"
+	assert.Equal(t, []string{"a", "b"}, c.GetStringSlice("tags"))
+
+	nested := c.GetNestedMap("server")
+	nested["labels"].(map[string]any)["env"] = "dev"
+	nested["hosts"].([]any)[0] = "changed"
+	assert.Equal(t, "prod", c.GetString("server.labels.env"))
+	assert.Equal(t, "h1", c.GetString("server.hosts[0]"))
+
+	stringMap := c.GetStringMap("server")
+	stringMap["labels"].(map[string]any)["env"] = "dev"
+	assert.Equal(t, "prod", c.GetString("server.labels.env"))
+
+	value, err := Get[any](c, "server")
+	require.NoError(t, err)
+	value.(map[string]any)["hosts"].([]any)[1] = "changed"
+	assert.Equal(t, "h2", c.GetString("server.hosts[1]"))
+
+	c.Set("server.extra", map[string]any{})
+
+	for _, leaf := range c.All() {
+		if empty, ok := leaf.(map[string]any); ok {
+			empty["added"] = true
+		}
+	}
+	assert.False(t, c.Has("server.extra.added"))
+}
+
 // Benchmark Tests for getters.go functions
 
 func BenchmarkConfig_GetString(b *testing.B) {
@@ -0,0 +1,716 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | hcl_parser.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// hclNumberPattern matches HCL number literals, with an optional minus sign.
+var hclNumberPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?([eE][+-]?[0-9]+)?`)
+
+// hclBlockNode tells whether a key created by a block holds its labels or its body.
+type hclBlockNode int
+
+const (
+	hclLabelNode hclBlockNode = iota + 1 // Map of the labels that follow the key
+	hclBodyNode                          // Block body, or a list of them if repeated
+)
+
+// hclParser holds the state of a single HCL document parse.
+type hclParser struct {
+	input     string
+	pos       int
+	line      int
+	lineStart int // Offset of the first byte of the current line
+}
+
+// parseHCL parses HCL2 native syntax into nested maps.
+// Attributes become keys, and a block becomes a map nested under its type and labels,
+// so `listener "http" { port = 80 }` is read as listener.http.port. A block repeated
+// with the same type and labels becomes a list of maps. Blocks of one type must have the
+// same number of labels and cannot merge into values set by attributes.
+// Literal values are supported: strings, heredocs, numbers (int64 or float64), booleans,
+// null, lists and objects. Template interpolations such as "${var.name}" are kept as
+// written; variables, function calls and operators are rejected.
+func parseHCL(content string) (map[string]any, error) {
+	p := &hclParser{input: strings.TrimPrefix(content, "\ufeff"), line: 1}
+
+	return p.parseBody(false)
+}
+
+// parseBody parses attributes and blocks up to the end of the input or,
+// if nested is set, up to the closing brace of the block.
+func (p *hclParser) parseBody(nested bool) (map[string]any, error) {
+	body := make(map[string]any)
+	attributes := make(map[string]bool)
+	blocks := make(map[string]hclBlockNode) // Keyed by the NUL-joined block keys
+
+	for {
+		if err := p.skipBlank(); err != nil {
+			return nil, err
+		}
+
+		if p.eof() {
+			if nested {
+				return nil, p.errorf("unclosed block, expected '}'")
+			}
+
+			return body, nil
+		}
+
+		if nested && p.consume("}") {
+			return body, nil
+		}
+
+		name := p.parseIdentifier()
+		if name == "" {
+			return nil, p.errorf("expected attribute or block name, found %q", p.peekRune())
+		}
+
+		if err := p.skipInline(); err != nil {
+			return nil, err
+		}
+
+		if p.consume("=") {
+			if _, exists := body[name]; exists {
+				return nil, p.errorf("duplicate attribute %q", name)
+			}
+
+			if err := p.skipInline(); err != nil {
+				return nil, err
+			}
+
+			value, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+
+			body[name] = value
+			attributes[name] = true
+		} else {
+			if attributes[name] {
+				return nil, p.errorf("%q is already defined as an attribute", name)
+			}
+
+			if err := p.parseBlock(body, blocks, name); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := p.expectLineEnd(nested); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseBlock parses the labels and body of a block of type name and stores it in body.
+// blocks records the keys of body created by earlier blocks, so that a block never
+// merges into an attribute value or into blocks of the same type with other labels.
+func (p *hclParser) parseBlock(body map[string]any, blocks map[string]hclBlockNode, name string) error {
+	keys := []string{name}
+
+	for !p.eof() && p.peek() != '{' {
+		var label string
+
+		if p.peek() == '"' {
+			value, err := p.parseQuotedString()
+			if err != nil {
+				return err
+			}
+
+			label = value
+		} else if label = p.parseIdentifier(); label == "" {
+			return p.errorf("expected '=', block label or '{' after %q, found %q", name, p.peekRune())
+		}
+
+		keys = append(keys, label)
+
+		if err := p.skipInline(); err != nil {
+			return err
+		}
+	}
+
+	if !p.consume("{") {
+		return p.errorf("expected '{' to open block %q", name)
+	}
+
+	block, err := p.parseBody(true)
+	if err != nil {
+		return err
+	}
+
+	node := body
+
+	for i, key := range keys[:len(keys)-1] {
+		if err := p.claimBlockKey(node, blocks, keys, i, hclLabelNode); err != nil {
+			return err
+		}
+
+		child, ok := node[key].(map[string]any)
+		if !ok {
+			child = make(map[string]any)
+			node[key] = child
+		}
+
+		node = child
+	}
+
+	if err := p.claimBlockKey(node, blocks, keys, len(keys)-1, hclBodyNode); err != nil {
+		return err
+	}
+
+	last := keys[len(keys)-1]
+
+	// Repeated blocks are collected into a list
+	switch existing := node[last].(type) {
+	case nil:
+		node[last] = block
+	case map[string]any:
+		node[last] = []any{existing, block}
+	case []any:
+		node[last] = append(existing, block)
+	default:
+		return p.errorf("block %q conflicts with an existing value", strings.Join(keys, "."))
+	}
+
+	return nil
+}
+
+// claimBlockKey records that keys[index], stored in node, is a block node of the given kind.
+// It fails if the key already holds an attribute value or a different kind of block node.
+func (p *hclParser) claimBlockKey(
+	node map[string]any, blocks map[string]hclBlockNode, keys []string, index int, kind hclBlockNode,
+) error {
+	path := strings.Join(keys[:index+1], "\x00")
+
+	existing, claimed := blocks[path]
+	if _, exists := node[keys[index]]; exists && !claimed {
+		return p.errorf("block %q conflicts with an existing value", strings.Join(keys, "."))
+	}
+
+	if claimed && existing != kind {
+		return p.errorf("block %q conflicts with an existing value: %q blocks must have the same number of labels",
+			strings.Join(keys, "."), keys[0])
+	}
+
+	blocks[path] = kind
+
+	return nil
+}
+
+// parseExpression parses a literal value expression.
+func (p *hclParser) parseExpression() (any, error) {
+	if p.eof() {
+		return nil, p.errorf("expected value")
+	}
+
+	switch {
+	case p.peek() == '"':
+		return p.parseQuotedString()
+	case strings.HasPrefix(p.input[p.pos:], "<<"):
+		return p.parseHeredoc()
+	case p.peek() == '[':
+		return p.parseList()
+	case p.peek() == '{':
+		return p.parseObject()
+	}
+
+	if number := hclNumberPattern.FindString(p.input[p.pos:]); number != "" {
+		p.pos += len(number)
+
+		if !strings.ContainsAny(number, ".eE") {
+			if parsed, err := strconv.ParseInt(number, 10, 64); err == nil {
+				return parsed, nil
+			}
+		}
+
+		parsed, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			p.pos -= len(number)
+
+			return nil, p.errorf("invalid number %s", number)
+		}
+
+		return parsed, nil
+	}
+
+	start := p.pos
+
+	switch name := p.parseIdentifier(); name {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	case "":
+		return nil, p.errorf("expected value, found %q", p.peekRune())
+	default:
+		p.pos = start
+
+		return nil, p.errorf("unsupported expression %q: only literal values are supported", name)
+	}
+}
+
+// parseList parses a list, which may span lines and end with a trailing comma.
+func (p *hclParser) parseList() ([]any, error) {
+	p.pos++ // [
+
+	result := []any{}
+
+	for {
+		if err := p.skipBlank(); err != nil {
+			return nil, err
+		}
+
+		if p.consume("]") {
+			return result, nil
+		}
+
+		value, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+
+		if err := p.skipBlank(); err != nil {
+			return nil, err
+		}
+
+		if p.consume("]") {
+			return result, nil
+		}
+
+		if !p.consume(",") {
+			return nil, p.errorf("expected ',' or ']' in list")
+		}
+	}
+}
+
+// parseObject parses an object whose items are separated by commas or newlines
+// and use "=" or ":" between key and value.
+func (p *hclParser) parseObject() (map[string]any, error) {
+	p.pos++ // {
+
+	result := make(map[string]any)
+
+	for {
+		if err := p.skipBlank(); err != nil {
+			return nil, err
+		}
+
+		if p.consume("}") {
+			return result, nil
+		}
+
+		var key string
+
+		if !p.eof() && p.peek() == '"' {
+			value, err := p.parseQuotedString()
+			if err != nil {
+				return nil, err
+			}
+
+			key = value
+		} else if key = p.parseIdentifier(); key == "" {
+			return nil, p.errorf("expected object key, found %q", p.peekRune())
+		}
+
+		if err := p.skipInline(); err != nil {
+			return nil, err
+		}
+
+		if !p.consume("=") && !p.consume(":") {
+			return nil, p.errorf("expected '=' or ':' after object key %q", key)
+		}
+
+		if err := p.skipInline(); err != nil {
+			return nil, err
+		}
+
+		value, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, exists := result[key]; exists {
+			return nil, p.errorf("duplicate object key %q", key)
+		}
+
+		result[key] = value
+
+		if err := p.skipInline(); err != nil {
+			return nil, err
+		}
+
+		if !p.consume(",") && p.peek() != '}' && !p.atLineEnd() {
+			return nil, p.errorf("expected ',', newline or '}' after object item")
+		}
+	}
+}
+
+// parseQuotedString parses a double-quoted template string.
+// Interpolations ("${...}") and directives ("%{...}") are kept as written;
+// "$${" and "%%{" stand for a literal "${" and "%{".
+func (p *hclParser) parseQuotedString() (string, error) {
+	p.pos++ // "
+
+	var builder strings.Builder
+
+	for {
+		if p.eof() || p.peek() == '\n' || p.peek() == '\r' {
+			return "", p.errorf("unterminated string")
+		}
+
+		rest := p.input[p.pos:]
+
+		switch {
+		case rest[0] == '"':
+			p.pos++
+
+			return builder.String(), nil
+		case rest[0] == '\\':
+			if err := p.parseEscape(&builder); err != nil {
+				return "", err
+			}
+		case strings.HasPrefix(rest, "$${") || strings.HasPrefix(rest, "%%{"):
+			builder.WriteString(rest[1:3])
+			p.pos += 3
+		case strings.HasPrefix(rest, "${") || strings.HasPrefix(rest, "%{"):
+			end, err := p.templateEnd()
+			if err != nil {
+				return "", err
+			}
+
+			builder.WriteString(p.input[p.pos:end])
+			p.pos = end
+		default:
+			builder.WriteByte(rest[0])
+			p.pos++
+		}
+	}
+}
+
+// templateEnd returns the offset just past the template sequence starting at the
+// current position, skipping nested braces and quoted strings inside it.
+func (p *hclParser) templateEnd() (int, error) {
+	depth := 0
+	quoted := false
+
+	for i := p.pos + 1; i < len(p.input); i++ {
+		switch ch := p.input[i]; {
+		case ch == '\n':
+			return 0, p.errorf("unterminated template sequence")
+		case quoted && ch == '\\':
+			i++
+		case ch == '"':
+			quoted = !quoted
+		case quoted:
+		case ch == '{':
+			depth++
+		case ch == '}':
+			depth--
+			if depth == 0 {
+				return i + 1, nil
+			}
+		}
+	}
+
+	return 0, p.errorf("unterminated template sequence")
+}
+
+// parseEscape decodes the escape sequence at the current backslash into builder.
+func (p *hclParser) parseEscape(builder *strings.Builder) error {
+	if p.pos+1 >= len(p.input) {
+		return p.errorf("unterminated string")
+	}
+
+	code := p.input[p.pos+1]
+
+	var digits int
+
+	switch code {
+	case 'n':
+		builder.WriteByte('\n')
+	case 'r':
+		builder.WriteByte('\r')
+	case 't':
+		builder.WriteByte('\t')
+	case '"', '\\':
+		builder.WriteByte(code)
+	case 'u':
+		digits = 4
+	case 'U':
+		digits = 8
+	default:
+		return p.errorf("invalid escape sequence \\%c", code)
+	}
+
+	if digits == 0 {
+		p.pos += 2
+
+		return nil
+	}
+
+	start := p.pos + 2
+	if start+digits > len(p.input) {
+		return p.errorf("incomplete unicode escape")
+	}
+
+	point, err := strconv.ParseUint(p.input[start:start+digits], 16, 32)
+	if err != nil || !utf8.ValidRune(rune(point)) {
+		return p.errorf("invalid unicode escape \\%c%s", code, p.input[start:start+digits])
+	}
+
+	builder.WriteRune(rune(point))
+	p.pos = start + digits
+
+	return nil
+}
+
+// parseHeredoc parses a <<MARKER or <<-MARKER heredoc. The value keeps the newline
+// of its last line; the indented form removes the indentation common to all lines.
+func (p *hclParser) parseHeredoc() (string, error) {
+	start, startLine, startLineStart := p.pos, p.line, p.lineStart
+
+	p.pos += 2 // <<
+	indented := p.consume("-")
+
+	marker := p.parseIdentifier()
+	if marker == "" {
+		return "", p.errorf("expected heredoc marker")
+	}
+
+	if !p.consume("\n") && !p.consume("\r\n") {
+		return "", p.errorf("expected newline after heredoc marker %q", marker)
+	}
+
+	p.newLine()
+
+	var lines []string
+
+	for {
+		if p.eof() {
+			p.pos, p.line, p.lineStart = start, startLine, startLineStart
+
+			return "", p.errorf("unterminated heredoc, expected %q", marker)
+		}
+
+		end := strings.IndexByte(p.input[p.pos:], '\n')
+		if end < 0 {
+			end = len(p.input) - p.pos
+		}
+
+		line := strings.TrimSuffix(p.input[p.pos:p.pos+end], "\r")
+
+		if strings.TrimSpace(line) == marker {
+			p.pos += len(strings.TrimRight(p.input[p.pos:p.pos+end], "\r"))
+
+			break
+		}
+
+		lines = append(lines, line)
+		p.pos += end
+
+		if p.consume("\n") {
+			p.newLine()
+		}
+	}
+
+	if indented {
+		lines = trimCommonIndent(lines)
+	}
+
+	var builder strings.Builder
+
+	for _, line := range lines {
+		line = strings.ReplaceAll(line, "$${", "${")
+		builder.WriteString(strings.ReplaceAll(line, "%%{", "%{"))
+		builder.WriteByte('\n')
+	}
+
+	return builder.String(), nil
+}
+
+// trimCommonIndent removes the leading whitespace shared by all non-blank lines.
+func trimCommonIndent(lines []string) []string {
+	indent := -1
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		width := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent < 0 || width < indent {
+			indent = width
+		}
+	}
+
+	if indent <= 0 {
+		return lines
+	}
+
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		// Blank lines may be shorter than the common indentation
+		width := len(line) - len(strings.TrimLeft(line, " \t"))
+		result[i] = line[min(indent, width):]
+	}
+
+	return result
+}
+
+// parseIdentifier reads an identifier of letters, digits, "_" and "-" not starting with a digit or "-".
+func (p *hclParser) parseIdentifier() string {
+	start := p.pos
+
+	for !p.eof() {
+		r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+
+		first := p.pos == start
+		if !(r == '_' || unicode.IsLetter(r) || !first && (r == '-' || unicode.IsDigit(r))) {
+			break
+		}
+
+		p.pos += size
+	}
+
+	return p.input[start:p.pos]
+}
+
+// expectLineEnd checks that an attribute or block is followed by a comment, a newline,
+// the end of the input or, inside a block, the closing brace.
+func (p *hclParser) expectLineEnd(nested bool) error {
+	if err := p.skipInline(); err != nil {
+		return err
+	}
+
+	if p.atLineEnd() || nested && p.peek() == '}' {
+		return nil
+	}
+
+	return p.errorf("unexpected %q, expected a newline", p.peekRune())
+}
+
+// atLineEnd reports whether the input is at a newline, a line comment or its end.
+func (p *hclParser) atLineEnd() bool {
+	rest := p.input[p.pos:]
+
+	return rest == "" || rest[0] == '\n' || strings.HasPrefix(rest, "\r\n") ||
+		rest[0] == '#' || strings.HasPrefix(rest, "//")
+}
+
+// skipBlank skips whitespace, newlines and comments.
+func (p *hclParser) skipBlank() error {
+	for {
+		if err := p.skipInline(); err != nil {
+			return err
+		}
+
+		switch {
+		case p.consume("\n") || p.consume("\r\n"):
+			p.newLine()
+		case p.consume("#") || p.consume("//"):
+			for !p.eof() && p.peek() != '\n' {
+				p.pos++
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+// skipInline skips spaces, tabs and block comments that do not leave the current line
+// of tokens; block comments may span lines.
+func (p *hclParser) skipInline() error {
+	for !p.eof() {
+		switch {
+		case p.peek() == ' ' || p.peek() == '\t':
+			p.pos++
+		case strings.HasPrefix(p.input[p.pos:], "/*"):
+			end := strings.Index(p.input[p.pos+2:], "*/")
+			if end < 0 {
+				return p.errorf("unterminated comment")
+			}
+
+			for _, ch := range []byte(p.input[p.pos : p.pos+end+4]) {
+				p.pos++
+
+				if ch == '\n' {
+					p.newLine()
+				}
+			}
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// newLine records that a line break was just consumed.
+func (p *hclParser) newLine() {
+	p.line++
+	p.lineStart = p.pos
+}
+
+// consume advances past token if the input continues with it.
+func (p *hclParser) consume(token string) bool {
+	if !strings.HasPrefix(p.input[p.pos:], token) {
+		return false
+	}
+
+	p.pos += len(token)
+
+	return true
+}
+
+// peek returns the byte at the current position, or 0 at the end of the input.
+func (p *hclParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+
+	return p.input[p.pos]
+}
+
+// peekRune returns the character at the current position for error messages.
+func (p *hclParser) peekRune() rune {
+	r, _ := utf8.DecodeRuneInString(p.input[p.pos:])
+
+	return r
+}
+
+// eof reports whether the whole input has been consumed.
+func (p *hclParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+// errorf returns an error describing a problem at the current line and column.
+func (p *hclParser) errorf(format string, args ...any) error {
+	column := utf8.RuneCountInString(p.input[p.lineStart:p.pos]) + 1
+
+	return fmt.Errorf("line %d, column %d: %s", p.line, column, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,284 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | hcl_parser_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHCLParser_Comprehensive tests HCL parsing with extensive coverage
+func TestHCLParser_Comprehensive(t *testing.T) {
+	t.Run("AttributesAndLiterals", func(t *testing.T) {
+		result, err := parseHCL(`
+# Service settings
+name    = "billing"
+port    = 8080
+ratio   = -1.5e3
+debug   = true
+enabled = false
+parent  = null // not set
+tags    = ["a", "b",
+  "c", # wrapped list
+]
+limits = { cpu = 2, "memory-mb": 512
+  nested = { deep = [] }
+}
+/* block
+   comment */ timeout = "30s"
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"name":    "billing",
+			"port":    int64(8080),
+			"ratio":   -1500.0,
+			"debug":   true,
+			"enabled": false,
+			"parent":  nil,
+			"tags":    []any{"a", "b", "c"},
+			"limits": map[string]any{
+				"cpu":       int64(2),
+				"memory-mb": int64(512),
+				"nested":    map[string]any{"deep": []any{}},
+			},
+			"timeout": "30s",
+		}, result)
+	})
+
+	t.Run("Blocks", func(t *testing.T) {
+		result, err := parseHCL(`
+server {
+  host = "0.0.0.0"
+}
+
+listener "http" {
+  port = 80
+}
+
+listener "https" {
+  port = 443
+  tls { cert = "server.pem" }
+}
+
+service "web" api-v2 { replicas = 3 }
+
+rule { action = "allow" }
+rule { action = "deny" }
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{"host": "0.0.0.0"}, result["server"])
+		assert.Equal(t, map[string]any{
+			"http":  map[string]any{"port": int64(80)},
+			"https": map[string]any{"port": int64(443), "tls": map[string]any{"cert": "server.pem"}},
+		}, result["listener"])
+		assert.Equal(t, map[string]any{
+			"web": map[string]any{"api-v2": map[string]any{"replicas": int64(3)}},
+		}, result["service"])
+		assert.Equal(t, []any{
+			map[string]any{"action": "allow"},
+			map[string]any{"action": "deny"},
+		}, result["rule"])
+	})
+
+	t.Run("Strings", func(t *testing.T) {
+		result, err := parseHCL(`
+escapes  = "tab\tquote\" slash\\ é \U0001F600"
+template = "http://${var.host}:${lookup(ports, "http")}/%{ if tls }s%{ endif }"
+literal  = "$${not_interpolated} %%{not_a_directive}"
+url      = "http://example.com/#anchor"
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, "tab\tquote\" slash\\ é 😀", result["escapes"])
+		assert.Equal(t, `http://${var.host}:${lookup(ports, "http")}/%{ if tls }s%{ endif }`, result["template"])
+		assert.Equal(t, "${not_interpolated} %{not_a_directive}", result["literal"])
+		assert.Equal(t, "http://example.com/#anchor", result["url"])
+	})
+
+	t.Run("Heredocs", func(t *testing.T) {
+		result, err := parseHCL("plain = <<EOT\n  first\n    second $${x}\nEOT\n" +
+			"indented = <<-EOT\r\n    first\r\n\r\n      second\r\n    EOT\r\n" +
+			"empty = <<EOT\nEOT\nafter = 1\n")
+		require.NoError(t, err)
+
+		assert.Equal(t, "  first\n    second ${x}\n", result["plain"])
+		assert.Equal(t, "first\n\n  second\n", result["indented"])
+		assert.Equal(t, "", result["empty"])
+		assert.Equal(t, int64(1), result["after"])
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		invalid := map[string]string{
+			"missing value":         "a =",
+			"missing equals":        "a 1",
+			"two attributes a line": "a = 1 b = 2",
+			"duplicate attribute":   "a = 1\na = 2",
+			"attribute then block":  "a = 1\na { }",
+			"block then attribute":  "a { }\na = 1",
+			"unclosed block":        "a {\n  b = 1\n",
+			"stray brace":           "}",
+			"variable":              "a = var.name",
+			"function call":         "a = upper(\"x\")",
+			"operator":              "a = 1 + 2",
+			"unterminated string":   "a = \"abc",
+			"newline in string":     "a = \"a\nb\"",
+			"invalid escape":        `a = "\q"`,
+			"short unicode escape":  `a = "\u12"`,
+			"unterminated template": `a = "${var"`,
+			"unterminated list":     "a = [1, 2",
+			"missing list comma":    "a = [1 2]",
+			"unterminated object":   "a = { b = 1",
+			"duplicate object key":  "a = { b = 1, b = 2 }",
+			"object missing equals": "a = { b 1 }",
+			"unterminated heredoc":  "a = <<EOT\ntext\n",
+			"heredoc without break": "a = <<EOT text\nEOT",
+			"unterminated comment":  "a = 1 /* open",
+			"label after body":      "a \"x\" { } \"y\"",
+		}
+
+		for name, content := range invalid {
+			_, err := parseHCL(content)
+			assert.Error(t, err, name)
+		}
+	})
+
+	t.Run("BlockConflictsWithAttribute", func(t *testing.T) {
+		_, err := parseHCL("service { web = 1 }\nservice \"web\" { port = 80 }")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `block "service.web" conflicts with an existing value`)
+
+		_, err = parseHCL("service { web = 1 }\nservice \"web\" \"v2\" { port = 80 }")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `block "service.web.v2" conflicts with an existing value`)
+
+		_, err = parseHCL("listener { http = { port = 1 } }\nlistener \"http\" { port = 2 }")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `block "listener.http" conflicts with an existing value`)
+
+		_, err = parseHCL("listener \"http\" {\n  tls = { cert = \"a.pem\" }\n}\nlistener \"http\" \"tls\" { }")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `block "listener.http.tls" conflicts with an existing value`)
+	})
+
+	t.Run("MixedLabelledAndUnlabelledBlocks", func(t *testing.T) {
+		invalid := map[string]string{
+			"unlabelled then labelled": "listener { port = 1 }\nlistener \"http\" { }",
+			"labelled then unlabelled": "listener \"http\" { }\nlistener { port = 1 }",
+			"repeated then labelled":   "rule { }\nrule { }\nrule \"x\" { }",
+			"fewer labels":             "service \"web\" \"v2\" { }\nservice \"web\" { }",
+			"more labels":              "service \"web\" { }\nservice \"web\" \"v2\" { }",
+		}
+
+		for name, content := range invalid {
+			_, err := parseHCL(content)
+			require.Error(t, err, name)
+			assert.Contains(t, err.Error(), "blocks must have the same number of labels", name)
+		}
+
+		result, err := parseHCL("listener \"http\" { port = 80 }\nlistener \"http\" { port = 8080 }")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"http": []any{map[string]any{"port": int64(80)}, map[string]any{"port": int64(8080)}},
+		}, result["listener"])
+	})
+
+	t.Run("ReportsLineAndColumn", func(t *testing.T) {
+		_, err := parseHCL("server {\n  port = 80\n  host = local.host\n}")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 3, column 10")
+		assert.Contains(t, err.Error(), "unsupported expression")
+
+		_, err = parseHCL("a = 1\nb = <<EOT\nnever closed")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 2, column 5")
+	})
+}
+
+// TestConfig_LoadFromFile_HCL tests loading HCL files through the config API
+func TestConfig_LoadFromFile_HCL(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.hcl")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+listener "http" {
+  port    = 8080
+  timeout = "30s"
+}
+
+listener "grpc" {
+  port = 9090
+}
+
+features = ["auth", "metrics"]
+`), 0o600))
+
+	c, err := New()
+	require.NoError(t, err)
+
+	require.NoError(t, c.LoadFromFile(configPath, &LoadOptions{IgnoreEnv: true}))
+
+	assert.Equal(t, 8080, c.GetInt("listener.http.port"))
+	assert.Equal(t, "30s", c.GetString("listener.http.timeout"))
+	assert.Equal(t, 9090, c.GetInt("listener.grpc.port"))
+	assert.Equal(t, []string{"auth", "metrics"}, c.GetStringSlice("features"))
+	assert.ElementsMatch(t, []string{"listener.http", "listener.grpc"}, c.GetNestedKeys("listener"))
+
+	t.Run("detected from extension", func(t *testing.T) {
+		assert.Equal(t, FormatHCL, detectFormat("config.hcl"))
+		assert.Equal(t, FormatHCL, detectFormat("/etc/app/CONFIG.HCL"))
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		badPath := filepath.Join(dir, "bad.hcl")
+		require.NoError(t, os.WriteFile(badPath, []byte("server {\n  port = 80\n"), 0o600))
+
+		err := c.LoadFromFile(badPath, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse HCL config")
+	})
+}
+
+// Benchmark Tests for hcl_parser.go functions
+
+func BenchmarkParseHCL(b *testing.B) {
+	content := `
+# Server settings
+server {
+  host    = "localhost"
+  timeout = "30s"
+}
+
+listener "http" { port = 8080 }
+listener "grpc" { port = 9090 }
+
+database = { host = "db.local", port = 5432, pool = [1, 2, 3] }
+features = ["auth", "api", "web"]
+`
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := parseHCL(content); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | helpers.go
 	::  ::          ::  ::    Created  | 2025-08-19
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -17,9 +17,10 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
-	"strings"
+	"reflect"
 )
 
 // applyDefaultsUnsafe applies default values for keys that don't exist.
@@ -30,32 +31,46 @@ func (c *Config) applyDefaultsUnsafe(defaults map[string]any) {
 	}
 
 	for key, value := range defaults {
-		if strings.Contains(key, ".") {
+		if c.isPathKey(key) {
 			// Nested key - check if it exists before setting
 			if !c.hasNestedKeyUnsafe(key) {
-				c.setNestedValueUnsafe(key, value)
+				c.setNestedValueUnsafe(key, deepCopy(value))
 			}
 		} else {
 			// Flat key - check if it exists before setting
-			if _, exists := c.data[key]; !exists {
-				c.data[key] = value
+			if _, exists := mapKey(c.dataUnsafe(), key, c.caseInsensitive); !exists {
+				c.writableDataUnsafe()[key] = deepCopy(value)
 			}
 		}
 	}
 }
 
 // loadFromEnvironmentUnsafe loads configuration from environment variables.
+// Values are coerced to the type of the value they replace.
 // This method assumes the caller holds the write lock.
-func (c *Config) loadFromEnvironmentUnsafe() {
+func (c *Config) loadFromEnvironmentUnsafe() error {
 	if c == nil {
-		return
+		return nil
 	}
 
-	for key := range c.data {
+	var errs []error
+
+	data := c.dataUnsafe()
+
+	for key, value := range data {
 		if envValue := os.Getenv(key); envValue != "" {
-			c.data[key] = envValue
+			coerced, err := coerceEnvValue(envValue, value, defaultEnvListSeparator, c.timeLayouts)
+			if err != nil {
+				errs = append(errs, envValueError(key, key, err))
+
+				continue
+			}
+
+			data[key] = coerced
 		}
 	}
+
+	return errors.Join(errs...)
 }
 
 // validateRequiredKeysUnsafe checks if all required keys are present.
@@ -66,21 +81,87 @@ func (c *Config) validateRequiredKeysUnsafe(requiredKeys []string) error {
 	}
 
 	for _, key := range requiredKeys {
-		// Check flat key first
-		if _, exists := c.data[key]; exists {
-			continue
+		if _, exists := c.lookupUnsafe(key); !exists {
+			return fmt.Errorf("%w: %q", ErrRequiredKeyMissing, key)
 		}
+	}
 
-		// If flat key doesn't exist and key contains dots, try nested access
-		if strings.Contains(key, ".") {
-			if c.hasNestedKeyUnsafe(key) {
-				continue
-			}
+	return nil
+}
+
+// deepCopy returns a copy of value that shares no maps or slices with it.
+// Pointers and other values are copied as they are.
+func deepCopy(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return deepCopyMap(v)
+	case []any:
+		if v == nil {
+			return v
 		}
 
-		// Key not found
-		return fmt.Errorf("%w: %q", ErrRequiredKeyMissing, key)
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = deepCopy(item)
+		}
+
+		return result
+	case []string:
+		if v == nil {
+			return v
+		}
+
+		return append([]string(nil), v...)
 	}
 
-	return nil
+	original := reflect.ValueOf(value)
+
+	switch original.Kind() {
+	case reflect.Slice:
+		if original.IsNil() {
+			return value
+		}
+
+		result := reflect.MakeSlice(original.Type(), original.Len(), original.Len())
+		for i := range original.Len() {
+			result.Index(i).Set(deepCopyValue(original.Index(i)))
+		}
+
+		return result.Interface()
+	case reflect.Map:
+		if original.IsNil() {
+			return value
+		}
+
+		result := reflect.MakeMapWithSize(original.Type(), original.Len())
+		iter := original.MapRange()
+
+		for iter.Next() {
+			result.SetMapIndex(iter.Key(), deepCopyValue(iter.Value()))
+		}
+
+		return result.Interface()
+	default:
+		return value
+	}
+}
+
+// deepCopyMap returns a deep copy of data; a nil map gives an empty map.
+func deepCopyMap(data map[string]any) map[string]any {
+	result := make(map[string]any, len(data))
+	for key, value := range data {
+		result[key] = deepCopy(value)
+	}
+
+	return result
+}
+
+// deepCopyValue deep-copies a slice element or map value of any type.
+func deepCopyValue(value reflect.Value) reflect.Value {
+	copied := deepCopy(value.Interface())
+	if copied == nil {
+		return reflect.Zero(value.Type())
+	}
+
+	return reflect.ValueOf(copied)
 }
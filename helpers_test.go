@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | helpers_test.go
 	::  ::          ::  ::    Created  | 2025-08-19
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -77,7 +77,7 @@ func TestHelpers_Comprehensive(t *testing.T) {
 		}()
 
 		// Load from environment
-		c.loadFromEnvironmentUnsafe()
+		require.NoError(t, c.loadFromEnvironmentUnsafe())
 
 		// Check that environment values override config values
 		assert.Equal(t, "9090", c.GetString("PORT"))
@@ -164,10 +164,25 @@ func TestHelpers_EdgeCases(t *testing.T) {
 	os.Setenv("TEST_COMPLEX_JSON", `{"nested": "value"}`)
 	defer os.Unsetenv("TEST_COMPLEX_JSON")
 
-	c.loadFromEnvironmentUnsafe()
+	require.NoError(t, c.loadFromEnvironmentUnsafe())
 	// The environment loader replaces existing keys with environment values
 	assert.Equal(t, `{"nested": "value"}`, c.GetString("TEST_COMPLEX_JSON"))
 
+	// Test environment variable loading keeps the type of the replaced value
+	c.Set("TEST_TYPED_PORT", 8080)
+
+	os.Setenv("TEST_TYPED_PORT", "9090")
+	defer os.Unsetenv("TEST_TYPED_PORT")
+
+	require.NoError(t, c.loadFromEnvironmentUnsafe())
+	assert.Equal(t, 9090, c.GetAll()["TEST_TYPED_PORT"])
+
+	os.Setenv("TEST_TYPED_PORT", "not_a_number")
+
+	err = c.loadFromEnvironmentUnsafe()
+	assert.ErrorIs(t, err, ErrInvalidEnvValue)
+	assert.Equal(t, 9090, c.GetAll()["TEST_TYPED_PORT"])
+
 	// Test applyDefaultsUnsafe with nil defaults
 	c.applyDefaultsUnsafe(nil)
 	// Should not panic
@@ -181,6 +196,46 @@ func TestHelpers_EdgeCases(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// TestDeepCopy tests that copies share no maps or slices with the original
+func TestDeepCopy(t *testing.T) {
+	original := map[string]any{
+		"server": map[string]any{
+			"hosts": []any{"a", map[string]any{"name": "b"}},
+			"tags":  []string{"x", "y"},
+		},
+		"ports":  []int{80, 443},
+		"labels": map[string]string{"env": "prod"},
+		"groups": map[string][]string{"admins": {"alice"}},
+		"empty":  []any(nil),
+		"name":   "api",
+	}
+
+	copied := deepCopyMap(original)
+	require.Equal(t, original, copied)
+
+	server := copied["server"].(map[string]any)
+	server["hosts"].([]any)[1].(map[string]any)["name"] = "changed"
+	server["tags"].([]string)[0] = "changed"
+	server["added"] = true
+	copied["ports"].([]int)[0] = 8080
+	copied["labels"].(map[string]string)["env"] = "dev"
+	copied["groups"].(map[string][]string)["admins"][0] = "mallory"
+
+	originalServer := original["server"].(map[string]any)
+	assert.Equal(t, "b", originalServer["hosts"].([]any)[1].(map[string]any)["name"])
+	assert.Equal(t, "x", originalServer["tags"].([]string)[0])
+	assert.NotContains(t, originalServer, "added")
+	assert.Equal(t, 80, original["ports"].([]int)[0])
+	assert.Equal(t, "prod", original["labels"].(map[string]string)["env"])
+	assert.Equal(t, "alice", original["groups"].(map[string][]string)["admins"][0])
+	assert.Nil(t, copied["empty"])
+
+	assert.NotNil(t, deepCopyMap(nil))
+	assert.Nil(t, deepCopy(nil))
+	assert.Equal(t, []any{nil, 1}, deepCopy([]any{nil, 1}))
+	assert.Equal(t, []error{nil}, deepCopy([]error{nil}))
+}
+
 // Benchmark Tests for helpers.go functions
 
 func BenchmarkConfig_ApplyDefaultsUnsafe(b *testing.B) {
@@ -249,3 +304,15 @@ func BenchmarkConfig_ValidateRequiredKeysUnsafe(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkDeepCopyMap(b *testing.B) {
+	data := map[string]any{
+		"server":   map[string]any{"host": "localhost", "port": 8080, "tags": []string{"a", "b"}},
+		"features": []any{"auth", "api", map[string]any{"name": "metrics"}},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = deepCopyMap(data)
+	}
+}
@@ -0,0 +1,559 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | jsonc_parser.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// jsoncMaxDepth limits the nesting of objects and arrays.
+const jsoncMaxDepth = 1000
+
+// jsoncParser holds the state of a single JSONC document parse.
+type jsoncParser struct {
+	input     string
+	pos       int
+	line      int
+	lineStart int // Offset of the first byte of the current line
+	depth     int
+}
+
+// parseJSONC parses JSON with comments and the JSON5 extensions: // and /* */ comments,
+// trailing commas, unquoted identifier keys, single-quoted strings, hex numbers,
+// leading or trailing decimal points, a leading plus sign, Infinity and NaN.
+// Numbers become float64, as with encoding/json, and the document must be an object.
+func parseJSONC(content string) (map[string]any, error) {
+	p := &jsoncParser{input: strings.TrimPrefix(content, "\ufeff"), line: 1}
+
+	if err := p.skipSpace(); err != nil {
+		return nil, err
+	}
+
+	if p.eof() {
+		return nil, p.errorf("unexpected end of input")
+	}
+
+	if p.peek() != '{' {
+		return nil, p.errorf("top-level value must be an object")
+	}
+
+	result, err := p.parseObject()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.skipSpace(); err != nil {
+		return nil, err
+	}
+
+	if !p.eof() {
+		return nil, p.errorf("unexpected %q after top-level object", p.peekRune())
+	}
+
+	return result, nil
+}
+
+// parseValue parses any value starting at the current position.
+func (p *jsoncParser) parseValue() (any, error) {
+	if p.eof() {
+		return nil, p.errorf("unexpected end of input")
+	}
+
+	switch ch := p.peek(); {
+	case ch == '{':
+		return p.parseObject()
+	case ch == '[':
+		return p.parseArray()
+	case ch == '"' || ch == '\'':
+		return p.parseString()
+	case ch == '-' || ch == '+' || ch == '.' || isDigit(ch):
+		return p.parseNumber()
+	}
+
+	word := p.input[p.pos:]
+	for _, literal := range []struct {
+		name  string
+		value any
+	}{{"true", true}, {"false", false}, {"null", nil}, {"Infinity", math.Inf(1)}, {"NaN", math.NaN()}} {
+		if strings.HasPrefix(word, literal.name) && !p.identifierContinues(len(literal.name)) {
+			p.pos += len(literal.name)
+
+			return literal.value, nil
+		}
+	}
+
+	return nil, p.errorf("unexpected %q", p.peekRune())
+}
+
+// parseObject parses an object; keys may be quoted strings or identifiers.
+func (p *jsoncParser) parseObject() (map[string]any, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	p.pos++ // {
+
+	result := make(map[string]any)
+
+	for {
+		if err := p.skipSpace(); err != nil {
+			return nil, err
+		}
+
+		if p.consume('}') {
+			return result, nil
+		}
+
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.skipSpace(); err != nil {
+			return nil, err
+		}
+
+		if !p.consume(':') {
+			return nil, p.errorf("expected ':' after object key %q", key)
+		}
+
+		if err := p.skipSpace(); err != nil {
+			return nil, err
+		}
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		// Later duplicates replace earlier ones, as with encoding/json
+		result[key] = value
+
+		if err := p.skipSpace(); err != nil {
+			return nil, err
+		}
+
+		if p.consume('}') {
+			return result, nil
+		}
+
+		if !p.consume(',') {
+			return nil, p.errorf("expected ',' or '}' in object")
+		}
+	}
+}
+
+// parseArray parses an array.
+func (p *jsoncParser) parseArray() ([]any, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	p.pos++ // [
+
+	result := []any{}
+
+	for {
+		if err := p.skipSpace(); err != nil {
+			return nil, err
+		}
+
+		if p.consume(']') {
+			return result, nil
+		}
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+
+		if err := p.skipSpace(); err != nil {
+			return nil, err
+		}
+
+		if p.consume(']') {
+			return result, nil
+		}
+
+		if !p.consume(',') {
+			return nil, p.errorf("expected ',' or ']' in array")
+		}
+	}
+}
+
+// parseKey parses an object key: a string or an identifier of letters, digits, "_" and "$".
+func (p *jsoncParser) parseKey() (string, error) {
+	if p.eof() {
+		return "", p.errorf("unexpected end of input")
+	}
+
+	if ch := p.peek(); ch == '"' || ch == '\'' {
+		return p.parseString()
+	}
+
+	start := p.pos
+
+	for !p.eof() {
+		r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+		if !isJSONCIdentifierRune(r, p.pos == start) {
+			break
+		}
+
+		p.pos += size
+	}
+
+	if p.pos == start {
+		return "", p.errorf("expected object key, found %q", p.peekRune())
+	}
+
+	return p.input[start:p.pos], nil
+}
+
+// parseString parses a double- or single-quoted string with JSON5 escapes.
+func (p *jsoncParser) parseString() (string, error) {
+	quote := p.peek()
+	p.pos++
+
+	var builder strings.Builder
+
+	for {
+		if p.eof() || p.peek() == '\n' || p.peek() == '\r' {
+			return "", p.errorf("unterminated string")
+		}
+
+		ch := p.peek()
+
+		switch ch {
+		case quote:
+			p.pos++
+
+			return builder.String(), nil
+		case '\\':
+			if err := p.parseEscape(&builder); err != nil {
+				return "", err
+			}
+		default:
+			builder.WriteByte(ch)
+			p.pos++
+		}
+	}
+}
+
+// parseEscape decodes the escape sequence at the current backslash into builder.
+// An escaped line break continues the string on the next line.
+func (p *jsoncParser) parseEscape(builder *strings.Builder) error {
+	p.pos++ // backslash
+
+	if p.eof() {
+		return p.errorf("unterminated string")
+	}
+
+	ch := p.peek()
+
+	simple := map[byte]string{
+		'b': "\b", 'f': "\f", 'n': "\n", 'r': "\r", 't': "\t", 'v': "\v",
+		'"': `"`, '\'': "'", '\\': `\`, '/': "/",
+	}
+
+	switch {
+	case simple[ch] != "":
+		builder.WriteString(simple[ch])
+		p.pos++
+	case ch == '0' && (p.pos+1 == len(p.input) || !isDigit(p.input[p.pos+1])):
+		builder.WriteByte(0)
+		p.pos++
+	case ch == '\n' || ch == '\r':
+		if p.consumeString("\r\n") || p.consume('\r') || p.consume('\n') {
+			p.line++
+			p.lineStart = p.pos
+		}
+	case ch == 'x':
+		code, err := p.parseHexDigits(2)
+		if err != nil {
+			return err
+		}
+
+		builder.WriteRune(rune(code))
+	case ch == 'u':
+		code, err := p.parseHexDigits(4)
+		if err != nil {
+			return err
+		}
+
+		r := rune(code)
+
+		// A high surrogate followed by an escaped low surrogate encodes one character
+		if utf16.IsSurrogate(r) && strings.HasPrefix(p.input[p.pos:], `\u`) {
+			saved := p.pos
+			p.pos++
+
+			if low, err := p.parseHexDigits(4); err == nil && utf16.DecodeRune(r, rune(low)) != utf8.RuneError {
+				r = utf16.DecodeRune(r, rune(low))
+			} else {
+				p.pos = saved
+			}
+		}
+
+		builder.WriteRune(r)
+	case isDigit(ch):
+		return p.errorf("invalid escape sequence \\%c", ch)
+	default:
+		// Any other character stands for itself
+		r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+		builder.WriteRune(r)
+		p.pos += size
+	}
+
+	return nil
+}
+
+// parseHexDigits reads the escape letter at the current position and the given number of hex digits after it.
+func (p *jsoncParser) parseHexDigits(count int) (uint64, error) {
+	start := p.pos + 1
+	if start+count > len(p.input) {
+		return 0, p.errorf("incomplete escape sequence")
+	}
+
+	code, err := strconv.ParseUint(p.input[start:start+count], 16, 32)
+	if err != nil {
+		return 0, p.errorf("invalid escape sequence \\%c%s", p.peek(), p.input[start:start+count])
+	}
+
+	p.pos = start + count
+
+	return code, nil
+}
+
+// parseNumber parses a decimal or hex number, Infinity or NaN with an optional sign.
+func (p *jsoncParser) parseNumber() (float64, error) {
+	start := p.pos
+	sign := 1.0
+
+	if p.consume('-') {
+		sign = -1
+	} else {
+		p.consume('+')
+	}
+
+	switch rest := p.input[p.pos:]; {
+	case strings.HasPrefix(rest, "Infinity"):
+		p.pos += len("Infinity")
+
+		return math.Inf(int(sign)), nil
+	case strings.HasPrefix(rest, "NaN"):
+		p.pos += len("NaN")
+
+		return math.NaN(), nil
+	case strings.HasPrefix(rest, "0x") || strings.HasPrefix(rest, "0X"):
+		p.pos += 2
+		digits := p.pos
+
+		for !p.eof() && strings.IndexByte("0123456789abcdefABCDEF", p.peek()) >= 0 {
+			p.pos++
+		}
+
+		parsed, err := strconv.ParseUint(p.input[digits:p.pos], 16, 64)
+		if err != nil {
+			p.pos = start
+
+			return 0, p.errorf("invalid hex number")
+		}
+
+		return sign * float64(parsed), nil
+	}
+
+	integer := p.skipDigits()
+	if integer > 1 && p.input[p.pos-integer] == '0' {
+		p.pos = start
+
+		return 0, p.errorf("number has a leading zero")
+	}
+
+	fraction := 0
+	if p.consume('.') {
+		fraction = p.skipDigits()
+	}
+
+	if integer == 0 && fraction == 0 {
+		p.pos = start
+
+		return 0, p.errorf("invalid number")
+	}
+
+	if !p.eof() && (p.peek() == 'e' || p.peek() == 'E') {
+		p.pos++
+
+		if !p.consume('+') {
+			p.consume('-')
+		}
+
+		if p.skipDigits() == 0 {
+			p.pos = start
+
+			return 0, p.errorf("invalid number exponent")
+		}
+	}
+
+	token := strings.TrimPrefix(p.input[start:p.pos], "+")
+
+	parsed, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		p.pos = start
+
+		return 0, p.errorf("invalid number %s", token)
+	}
+
+	return parsed, nil
+}
+
+// skipDigits advances past decimal digits and returns how many there were.
+func (p *jsoncParser) skipDigits() int {
+	start := p.pos
+	for !p.eof() && isDigit(p.peek()) {
+		p.pos++
+	}
+
+	return p.pos - start
+}
+
+// skipSpace skips whitespace, line breaks and comments.
+func (p *jsoncParser) skipSpace() error {
+	for !p.eof() {
+		switch {
+		case p.peek() == '\n':
+			p.pos++
+			p.line++
+			p.lineStart = p.pos
+		case strings.IndexByte(" \t\r\v\f", p.peek()) >= 0:
+			p.pos++
+		case p.consumeString("//"):
+			for !p.eof() && p.peek() != '\n' {
+				p.pos++
+			}
+		case strings.HasPrefix(p.input[p.pos:], "/*"):
+			end := strings.Index(p.input[p.pos+2:], "*/")
+			if end < 0 {
+				return p.errorf("unterminated comment")
+			}
+
+			for _, ch := range []byte(p.input[p.pos : p.pos+end+4]) {
+				p.pos++
+
+				if ch == '\n' {
+					p.line++
+					p.lineStart = p.pos
+				}
+			}
+		default:
+			r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+			if r == utf8.RuneError || !unicode.IsSpace(r) && r != '\ufeff' {
+				return nil
+			}
+
+			p.pos += size
+		}
+	}
+
+	return nil
+}
+
+// enter increases the nesting depth, failing beyond jsoncMaxDepth.
+func (p *jsoncParser) enter() error {
+	p.depth++
+	if p.depth > jsoncMaxDepth {
+		return p.errorf("nesting exceeds %d levels", jsoncMaxDepth)
+	}
+
+	return nil
+}
+
+// leave decreases the nesting depth.
+func (p *jsoncParser) leave() {
+	p.depth--
+}
+
+// identifierContinues reports whether an identifier character follows the next n bytes.
+func (p *jsoncParser) identifierContinues(n int) bool {
+	r, _ := utf8.DecodeRuneInString(p.input[p.pos+n:])
+
+	return isJSONCIdentifierRune(r, false)
+}
+
+// consume advances past ch if it is the next byte.
+func (p *jsoncParser) consume(ch byte) bool {
+	if p.eof() || p.peek() != ch {
+		return false
+	}
+
+	p.pos++
+
+	return true
+}
+
+// consumeString advances past token if the input continues with it.
+func (p *jsoncParser) consumeString(token string) bool {
+	if !strings.HasPrefix(p.input[p.pos:], token) {
+		return false
+	}
+
+	p.pos += len(token)
+
+	return true
+}
+
+// peek returns the byte at the current position; callers check eof first.
+func (p *jsoncParser) peek() byte {
+	return p.input[p.pos]
+}
+
+// peekRune returns the character at the current position for error messages.
+func (p *jsoncParser) peekRune() rune {
+	r, _ := utf8.DecodeRuneInString(p.input[p.pos:])
+
+	return r
+}
+
+// eof reports whether the whole input has been consumed.
+func (p *jsoncParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+// errorf returns an error describing a problem at the current line and column.
+func (p *jsoncParser) errorf(format string, args ...any) error {
+	column := utf8.RuneCountInString(p.input[p.lineStart:p.pos]) + 1
+
+	return fmt.Errorf("line %d, column %d: %s", p.line, column, fmt.Sprintf(format, args...))
+}
+
+// isJSONCIdentifierRune reports whether r may appear in an unquoted key; digits may not start one.
+func isJSONCIdentifierRune(r rune, first bool) bool {
+	if r == '_' || r == '$' || unicode.IsLetter(r) {
+		return true
+	}
+
+	return !first && (unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Pc, r))
+}
@@ -0,0 +1,230 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | jsonc_parser_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestJSONCParser_Comprehensive tests JSONC and JSON5 parsing with extensive coverage
+func TestJSONCParser_Comprehensive(t *testing.T) {
+	t.Run("MatchesEncodingJSON", func(t *testing.T) {
+		content := `{"server": {"host": "localhost", "port": 8080, "ratio": -1.5e3},
+			"features": ["auth", true, null, 0.25], "empty": {}, "list": [],
+			"escapes": "quote\" slash\/ tab\t unicodeé pair😀"}`
+
+		var expected map[string]any
+		require.NoError(t, json.Unmarshal([]byte(content), &expected))
+
+		result, err := parseJSONC(content)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("CommentsAndTrailingCommas", func(t *testing.T) {
+		result, err := parseJSONC(`
+// Service configuration
+{
+    /* The address
+       to listen on */
+    "host": "0.0.0.0", // all interfaces
+    "ports": [80, 443,],
+    "tls": {"enabled": true,},
+    "url": "http://example.com/*not a comment*/",
+}
+// trailing comment`)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"host":  "0.0.0.0",
+			"ports": []any{80.0, 443.0},
+			"tls":   map[string]any{"enabled": true},
+			"url":   "http://example.com/*not a comment*/",
+		}, result)
+	})
+
+	t.Run("JSON5Extensions", func(t *testing.T) {
+		result, err := parseJSONC(`{
+  unquoted: 'single quotes',
+  $special_key1: "ok",
+  'quoted': 'it\'s "fine"',
+  hex: 0xDEADbeef,
+  negativeHex: -0x10,
+  leadingDot: .5,
+  trailingDot: 5.,
+  plus: +7,
+  infinity: -Infinity,
+  nan: NaN,
+  continued: "line one \
+line two",
+  escapes: '\x41\v\0',
+  café: "unicode key",
+}`)
+		require.NoError(t, err)
+
+		assert.Equal(t, "single quotes", result["unquoted"])
+		assert.Equal(t, "ok", result["$special_key1"])
+		assert.Equal(t, `it's "fine"`, result["quoted"])
+		assert.Equal(t, float64(0xDEADBEEF), result["hex"])
+		assert.Equal(t, -16.0, result["negativeHex"])
+		assert.Equal(t, 0.5, result["leadingDot"])
+		assert.Equal(t, 5.0, result["trailingDot"])
+		assert.Equal(t, 7.0, result["plus"])
+		assert.Equal(t, math.Inf(-1), result["infinity"])
+		assert.True(t, math.IsNaN(result["nan"].(float64)))
+		assert.Equal(t, "line one line two", result["continued"])
+		assert.Equal(t, "A\v\x00", result["escapes"])
+		assert.Equal(t, "unicode key", result["café"])
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		invalid := map[string]string{
+			"empty":                 "",
+			"comment only":          "// nothing",
+			"top-level array":       "[1, 2]",
+			"unterminated object":   `{"a": 1`,
+			"unterminated string":   `{"a": "abc}`,
+			"newline in string":     "{\"a\": \"a\nb\"}",
+			"unterminated comment":  `{"a": 1 /* open`,
+			"missing colon":         `{"a" 1}`,
+			"missing comma":         `{"a": 1 "b": 2}`,
+			"double comma":          `{"a": 1,, "b": 2}`,
+			"leading comma":         `[,1]`,
+			"leading zero":          `{"a": 012}`,
+			"bare word":             `{"a": yes}`,
+			"partial literal":       `{"a": truex}`,
+			"number key start":      `{1a: 1}`,
+			"invalid escape":        `{"a": "\1"}`,
+			"short unicode escape":  `{"a": "\u12"}`,
+			"invalid hex":           `{"a": 0xZZ}`,
+			"missing exponent":      `{"a": 1e}`,
+			"lone sign":             `{"a": -}`,
+			"text after object":     `{"a": 1} extra`,
+			"second top-level":      `{"a": 1} {"b": 2}`,
+			"mismatched quotes":     `{"a": 'value"}`,
+			"missing object value":  `{"a": }`,
+			"unterminated in array": `{"a": [1, 2}`,
+		}
+
+		for name, content := range invalid {
+			_, err := parseJSONC(content)
+			assert.Error(t, err, name)
+		}
+	})
+
+	t.Run("ReportsLineAndColumn", func(t *testing.T) {
+		_, err := parseJSONC("{\n  // comment\n  \"a\": 1,\n  \"b\": @\n}")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 4, column 8")
+
+		_, err = parseJSONC("{\n  /* multi\n     line */ \"a\" 1\n}")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 3, column 18")
+
+		_, err = parseJSONC("{\"é\": é}")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 1, column 7")
+	})
+
+	t.Run("NestingLimit", func(t *testing.T) {
+		deep := `{"a":` + strings.Repeat("[", jsoncMaxDepth) + strings.Repeat("]", jsoncMaxDepth) + "}"
+
+		_, err := parseJSONC(deep)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "nesting exceeds")
+	})
+}
+
+// TestConfig_LoadFromFile_JSONC tests loading JSONC and JSON5 files through the config API
+func TestConfig_LoadFromFile_JSONC(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.jsonc")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`{
+    // Server settings
+    "server": {
+        "port": 8080,
+        "timeout": "30s", // per request
+    },
+    /* enabled features */
+    "features": ["auth", "metrics",],
+}`), 0o600))
+
+	c, err := New()
+	require.NoError(t, err)
+
+	require.NoError(t, c.LoadFromFile(configPath, &LoadOptions{IgnoreEnv: true}))
+
+	assert.Equal(t, 8080, c.GetInt("server.port"))
+	assert.Equal(t, "30s", c.GetString("server.timeout"))
+	assert.Equal(t, []string{"auth", "metrics"}, c.GetStringSlice("features"))
+
+	t.Run("JSON5 file", func(t *testing.T) {
+		json5Path := filepath.Join(dir, "config.json5")
+		require.NoError(t, os.WriteFile(json5Path, []byte("{server: {port: 0x1F90, host: 'localhost'}}"), 0o600))
+
+		c, err := New()
+		require.NoError(t, err)
+
+		require.NoError(t, c.LoadFromFile(json5Path, &LoadOptions{IgnoreEnv: true}))
+		assert.Equal(t, 8080, c.GetInt("server.port"))
+		assert.Equal(t, "localhost", c.GetString("server.host"))
+	})
+
+	t.Run("detected from extension", func(t *testing.T) {
+		assert.Equal(t, FormatJSONC, detectFormat("config.jsonc"))
+		assert.Equal(t, FormatJSONC, detectFormat("CONFIG.JSON5"))
+		assert.Equal(t, FormatJSON, detectFormat("config.json"))
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		badPath := filepath.Join(dir, "bad.jsonc")
+		require.NoError(t, os.WriteFile(badPath, []byte("{\n  \"a\": 1\n  \"b\": 2\n}"), 0o600))
+
+		err := c.LoadFromFile(badPath, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse JSONC config")
+		assert.Contains(t, err.Error(), "line 3, column 3")
+	})
+}
+
+// Benchmark Tests for jsonc_parser.go functions
+
+func BenchmarkParseJSONC(b *testing.B) {
+	content := `{
+    // Server settings
+    "server": {"host": "localhost", "port": 8080, "timeout": "30s",},
+    /* Database settings */
+    database: {host: 'db.local', port: 5432, pool: [1, 2, 3,]},
+    "features": ["auth", "api", "web"],
+}`
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := parseJSONC(content); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
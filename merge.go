@@ -0,0 +1,167 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | merge.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MergeMap deep-merges data into the current configuration using the given strategy.
+// Unlike LoadFromMap, nested maps are merged recursively, so siblings of the merged keys are preserved.
+// If the strategy reports type conflicts as errors, the configuration is left unchanged on error.
+func (c *Config) MergeMap(data map[string]any, strategy MergeStrategy) error {
+	if c == nil {
+		return ErrConfigNil
+	}
+
+	c.locker().Lock()
+	defer c.locker().Unlock()
+
+	if err := c.checkKeyCollisions(data); err != nil {
+		return err
+	}
+
+	if strategy.Conflicts == ConflictError {
+		if err := findMergeConflict(c.dataUnsafe(), data, "", c.caseInsensitive); err != nil {
+			return err
+		}
+	}
+
+	mergeMaps(c.writableDataUnsafe(), data, strategy, c.caseInsensitive)
+
+	return nil
+}
+
+// mergeMaps deep-merges src into dst.
+// Nested maps present on both sides are merged recursively,
+// slices are combined according to the strategy and
+// any other value from src replaces the value in dst.
+// If fold is set, keys of src replace keys of dst that differ only by case, keeping the spelling of dst.
+func mergeMaps(dst, src map[string]any, strategy MergeStrategy, fold bool) {
+	for srcKey, srcValue := range src {
+		key, _ := mapKey(dst, srcKey, fold)
+
+		if srcMap, ok := srcValue.(map[string]any); ok {
+			if dstMap, ok := dst[key].(map[string]any); ok {
+				mergeMaps(dstMap, srcMap, strategy, fold)
+
+				continue
+			}
+
+			// Copy the map so later merges never modify the source
+			nested := make(map[string]any, len(srcMap))
+			mergeMaps(nested, srcMap, strategy, fold)
+			dst[key] = nested
+
+			continue
+		}
+
+		if dstValue, exists := dst[key]; exists && strategy.Slices != SliceReplace {
+			if merged, ok := mergeSlices(dstValue, srcValue, strategy.Slices); ok {
+				dst[key] = merged
+
+				continue
+			}
+		}
+
+		dst[key] = deepCopy(srcValue)
+	}
+}
+
+// mergeSlices combines two slices according to mode.
+// Returns false if either value is not a slice.
+func mergeSlices(dst, src any, mode SliceMergeMode) (any, bool) {
+	dstValue := reflect.ValueOf(dst)
+	srcValue := reflect.ValueOf(src)
+
+	if dstValue.Kind() != reflect.Slice || srcValue.Kind() != reflect.Slice {
+		return nil, false
+	}
+
+	// Slices of the same type keep their type, mixed slices become []any
+	var result reflect.Value
+	if dstValue.Type() == srcValue.Type() {
+		result = reflect.MakeSlice(dstValue.Type(), 0, dstValue.Len()+srcValue.Len())
+	} else {
+		result = reflect.ValueOf(make([]any, 0, dstValue.Len()+srcValue.Len()))
+	}
+
+	elemType := result.Type().Elem()
+
+	for _, value := range []reflect.Value{dstValue, srcValue} {
+		for i := range value.Len() {
+			item := value.Index(i)
+			if mode == SliceAppendUnique && sliceContains(result, item.Interface()) {
+				continue
+			}
+
+			result = reflect.Append(result, deepCopyValue(item).Convert(elemType))
+		}
+	}
+
+	return result.Interface(), true
+}
+
+// sliceContains reports whether slice contains an element deeply equal to item.
+func sliceContains(slice reflect.Value, item any) bool {
+	for i := range slice.Len() {
+		if reflect.DeepEqual(slice.Index(i).Interface(), item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findMergeConflict reports the first key where merging src into dst
+// would replace a map or slice with a value of a different shape.
+func findMergeConflict(dst, src map[string]any, prefix string, fold bool) error {
+	for key, srcValue := range src {
+		dstKey, exists := mapKey(dst, key, fold)
+
+		dstValue := dst[dstKey]
+		if !exists || dstValue == nil || srcValue == nil {
+			continue
+		}
+
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		dstMap, dstIsMap := dstValue.(map[string]any)
+		srcMap, srcIsMap := srcValue.(map[string]any)
+
+		if dstIsMap && srcIsMap {
+			if err := findMergeConflict(dstMap, srcMap, path, fold); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		dstIsSlice := reflect.ValueOf(dstValue).Kind() == reflect.Slice
+		srcIsSlice := reflect.ValueOf(srcValue).Kind() == reflect.Slice
+
+		if dstIsMap != srcIsMap || dstIsSlice != srcIsSlice {
+			return fmt.Errorf("%w: %q: cannot merge %T into %T", ErrMergeConflict, path, srcValue, dstValue)
+		}
+	}
+
+	return nil
+}
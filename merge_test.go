@@ -0,0 +1,165 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | merge_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMergeMaps_Comprehensive tests deep merging of configuration maps
+func TestMergeMaps_Comprehensive(t *testing.T) {
+	t.Run("MergesNestedMaps", func(t *testing.T) {
+		dst := map[string]any{
+			"server": map[string]any{"host": "localhost", "port": 8080},
+			"debug":  false,
+		}
+		src := map[string]any{
+			"server": map[string]any{"port": 9090},
+			"debug":  true,
+		}
+
+		mergeMaps(dst, src, MergeStrategy{}, false)
+
+		assert.Equal(t, map[string]any{
+			"server": map[string]any{"host": "localhost", "port": 9090},
+			"debug":  true,
+		}, dst)
+	})
+
+	t.Run("ReplacesNonMapValues", func(t *testing.T) {
+		dst := map[string]any{"value": map[string]any{"nested": 1}, "list": []any{1, 2}}
+		src := map[string]any{"value": "scalar", "list": []any{3}}
+
+		mergeMaps(dst, src, MergeStrategy{}, false)
+
+		assert.Equal(t, "scalar", dst["value"])
+		assert.Equal(t, []any{3}, dst["list"])
+	})
+
+	t.Run("DoesNotAliasSource", func(t *testing.T) {
+		dst := map[string]any{}
+		src := map[string]any{"server": map[string]any{"host": "localhost"}}
+
+		mergeMaps(dst, src, MergeStrategy{}, false)
+		mergeMaps(dst, map[string]any{"server": map[string]any{"port": 80}}, MergeStrategy{}, false)
+
+		assert.NotContains(t, src["server"], "port")
+	})
+}
+
+// TestMergeMap_Strategies tests MergeMap with the different merge strategies
+func TestMergeMap_Strategies(t *testing.T) {
+	newConfig := func(t *testing.T) *Config {
+		t.Helper()
+
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{
+			"server": map[string]any{
+				"host": "localhost",
+				"port": 8080,
+				"tags": []any{"a", "b"},
+			},
+			"hosts": []string{"one", "two"},
+		})
+
+		return c
+	}
+
+	t.Run("PreservesSiblings", func(t *testing.T) {
+		c := newConfig(t)
+
+		err := c.MergeMap(map[string]any{"server": map[string]any{"port": 9090}}, MergeStrategy{})
+		require.NoError(t, err)
+
+		assert.Equal(t, "localhost", c.GetString("server.host"))
+		assert.Equal(t, 9090, c.GetInt("server.port"))
+	})
+
+	t.Run("SliceReplace", func(t *testing.T) {
+		c := newConfig(t)
+
+		err := c.MergeMap(map[string]any{"server": map[string]any{"tags": []any{"c"}}}, MergeStrategy{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"c"}, c.GetStringSlice("server.tags"))
+	})
+
+	t.Run("SliceAppend", func(t *testing.T) {
+		c := newConfig(t)
+
+		err := c.MergeMap(map[string]any{
+			"server": map[string]any{"tags": []any{"b", "c"}},
+			"hosts":  []string{"two", "three"},
+		}, MergeStrategy{Slices: SliceAppend})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"a", "b", "b", "c"}, c.GetStringSlice("server.tags"))
+		assert.Equal(t, []string{"one", "two", "two", "three"}, c.GetStringSlice("hosts"))
+	})
+
+	t.Run("SliceAppendUnique", func(t *testing.T) {
+		c := newConfig(t)
+
+		err := c.MergeMap(map[string]any{
+			"server": map[string]any{"tags": []string{"b", "c"}},
+		}, MergeStrategy{Slices: SliceAppendUnique})
+		require.NoError(t, err)
+
+		// Mixed slice types are combined into []any
+		assert.Equal(t, []any{"a", "b", "c"}, c.GetAll()["server"].(map[string]any)["tags"])
+	})
+
+	t.Run("ConflictOverwrite", func(t *testing.T) {
+		c := newConfig(t)
+
+		err := c.MergeMap(map[string]any{"server": "disabled"}, MergeStrategy{})
+		require.NoError(t, err)
+
+		assert.Equal(t, "disabled", c.GetString("server"))
+	})
+
+	t.Run("ConflictError", func(t *testing.T) {
+		c := newConfig(t)
+
+		err := c.MergeMap(map[string]any{
+			"server": map[string]any{"port": 9090, "tags": "single"},
+		}, MergeStrategy{Conflicts: ConflictError})
+		require.ErrorIs(t, err, ErrMergeConflict)
+		assert.Contains(t, err.Error(), "server.tags")
+
+		// Nothing is merged when a conflict is found
+		assert.Equal(t, 8080, c.GetInt("server.port"))
+
+		err = c.MergeMap(map[string]any{"server": []any{1}}, MergeStrategy{Conflicts: ConflictError})
+		assert.ErrorIs(t, err, ErrMergeConflict)
+
+		// Scalars of different types are not conflicts
+		err = c.MergeMap(map[string]any{"server": map[string]any{"port": "9090"}}, MergeStrategy{Conflicts: ConflictError})
+		require.NoError(t, err)
+		assert.Equal(t, 9090, c.GetInt("server.port"))
+	})
+
+	t.Run("NilConfig", func(t *testing.T) {
+		var c *Config
+		assert.ErrorIs(t, c.MergeMap(map[string]any{}, MergeStrategy{}), ErrConfigNil)
+	})
+}
@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | nested.go
 	::  ::          ::  ::    Created  | 2025-08-19
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -16,45 +16,405 @@
 
 package config
 
-import "strings"
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
 
-// getNestedValueUnsafe retrieves a nested value using dot notation (e.g., "server.host").
-// This method assumes the caller holds the appropriate lock.
-func (c *Config) getNestedValueUnsafe(key string) (any, bool) {
-	if c == nil {
-		return nil, false
+// pathSegment is one step of a nested key path.
+type pathSegment struct {
+	key     string // Map key
+	index   int    // Slice index, negative values count from the end
+	isIndex bool   // Written in brackets ("hosts[1]"), addresses slices only
+	numeric bool   // Dotted part that parses as an integer ("hosts.1"), addresses slices and maps
+}
+
+// defaultKeyDelimiter separates nested key parts unless WithKeyDelimiter is used.
+const defaultKeyDelimiter = "."
+
+// keyEscapes are the characters a backslash escapes in keys, in addition to the delimiter.
+const keyEscapes = `\[]"'`
+
+// delimiter returns the separator of nested key parts.
+func (c *Config) delimiter() string {
+	if c.keyDelimiter == "" {
+		return defaultKeyDelimiter
+	}
+
+	return c.keyDelimiter
+}
+
+// isPathKey reports whether key uses nested, index or escape notation.
+func (c *Config) isPathKey(key string) bool {
+	return strings.Contains(key, c.delimiter()) || strings.ContainsAny(key, `[\`)
+}
+
+// parseKeyPath splits a key like "upstreams[1].host" or "upstreams.1.host" into path segments.
+// A backslash escapes the delimiter (`hosts.api\.example\.com`), and quoted brackets
+// hold keys verbatim (`hosts["api.example.com"].port`).
+func (c *Config) parseKeyPath(key string) []pathSegment {
+	delimiter := c.delimiter()
+	parts := splitKeyParts(key, delimiter)
+	segments := make([]pathSegment, 0, len(parts))
+
+	for _, part := range parts {
+		segments = append(segments, parseKeyPart(part, delimiter)...)
 	}
 
-	parts := strings.Split(key, ".")
-	current := c.data
+	return segments
+}
+
+// splitKeyParts splits key on delimiters that are neither escaped nor inside quoted brackets.
+func splitKeyParts(key, delimiter string) []string {
+	var (
+		parts []string
+		quote byte
+	)
+
+	start := 0
 
-	for i, part := range parts {
-		if i == len(parts)-1 {
-			// Last part - get the final value
-			if value, exists := current[part]; exists {
-				return value, true
+	for i := 0; i < len(key); i++ {
+		switch {
+		case key[i] == '\\':
+			i += escapedLength(key[i+1:], delimiter)
+		case quote != 0:
+			if key[i] == quote {
+				quote = 0
 			}
+		case key[i] == '[' && i+1 < len(key) && (key[i+1] == '"' || key[i+1] == '\''):
+			quote = key[i+1]
+			i++
+		case strings.HasPrefix(key[i:], delimiter):
+			parts = append(parts, key[start:i])
+			start = i + len(delimiter)
+			i = start - 1
+		}
+	}
 
+	return append(parts, key[start:])
+}
+
+// escapedLength returns the length of the text escaped by a backslash preceding rest.
+func escapedLength(rest, delimiter string) int {
+	switch {
+	case rest == "":
+		return 0
+	case strings.HasPrefix(rest, delimiter):
+		return len(delimiter)
+	default:
+		return 1
+	}
+}
+
+// parseKeyPart parses one part of a key: a name followed by optional bracket indexes or quoted keys.
+// Parts with malformed brackets are kept as literal map keys.
+func parseKeyPart(part, delimiter string) []pathSegment {
+	open := indexUnescaped(part, '[', delimiter)
+	if open < 0 {
+		return []pathSegment{nameSegment(part, delimiter)}
+	}
+
+	brackets, ok := parseBrackets(part[open:], delimiter)
+	if !ok || indexUnescaped(part[:open], ']', delimiter) >= 0 {
+		return []pathSegment{nameSegment(part, delimiter)}
+	}
+
+	if open == 0 {
+		return brackets
+	}
+
+	return append([]pathSegment{nameSegment(part[:open], delimiter)}, brackets...)
+}
+
+// parseBrackets parses a sequence of bracket groups like "[0]['api.example.com'][-1]".
+func parseBrackets(text, delimiter string) ([]pathSegment, bool) {
+	var segments []pathSegment
+
+	for text != "" {
+		if len(text) < 2 || text[0] != '[' {
 			return nil, false
 		}
 
-		// Navigate deeper into nested structure
-		if value, exists := current[part]; exists {
-			if nestedMap, ok := value.(map[string]any); ok {
-				current = nestedMap
-			} else {
-				// Path doesn't lead to a map, can't continue
+		if text[1] == '"' || text[1] == '\'' {
+			closing := closingQuote(text, 1)
+			if closing < 0 || closing+1 >= len(text) || text[closing+1] != ']' {
 				return nil, false
 			}
-		} else {
-			// Key doesn't exist in path
+
+			segments = append(segments, pathSegment{key: unescapeKey(text[2:closing], delimiter)})
+			text = text[closing+2:]
+
+			continue
+		}
+
+		closing := strings.IndexByte(text, ']')
+		if closing < 0 {
+			return nil, false
+		}
+
+		index, err := strconv.Atoi(text[1:closing])
+		if err != nil {
 			return nil, false
 		}
+
+		segments = append(segments, pathSegment{index: index, isIndex: true})
+		text = text[closing+1:]
+	}
+
+	return segments, true
+}
+
+// closingQuote returns the position of the unescaped quote closing the one at open, or -1.
+func closingQuote(text string, open int) int {
+	for i := open + 1; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case text[open]:
+			return i
+		}
+	}
+
+	return -1
+}
+
+// indexUnescaped returns the position of the first unescaped char in text, or -1.
+func indexUnescaped(text string, char byte, delimiter string) int {
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i += escapedLength(text[i+1:], delimiter)
+		case char:
+			return i
+		}
+	}
+
+	return -1
+}
+
+// nameSegment creates a map key segment from an unquoted name.
+// Escaped names are always map keys, plain integer names may also address slices.
+func nameSegment(name, delimiter string) pathSegment {
+	if strings.Contains(name, `\`) {
+		return pathSegment{key: unescapeKey(name, delimiter)}
+	}
+
+	return keySegment(name)
+}
+
+// keySegment creates a map key segment, marking integer keys as possible slice indexes.
+func keySegment(key string) pathSegment {
+	index, err := strconv.Atoi(key)
+
+	return pathSegment{key: key, index: index, numeric: err == nil}
+}
+
+// unescapeKey removes backslashes escaping the delimiter or one of keyEscapes.
+// Other backslashes are kept as they are.
+func unescapeKey(text, delimiter string) string {
+	if !strings.Contains(text, `\`) {
+		return text
+	}
+
+	var builder strings.Builder
+
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\\' && i+1 < len(text) {
+			rest := text[i+1:]
+
+			if strings.HasPrefix(rest, delimiter) {
+				builder.WriteString(delimiter)
+				i += len(delimiter)
+
+				continue
+			}
+
+			if strings.IndexByte(keyEscapes, rest[0]) >= 0 {
+				builder.WriteByte(rest[0])
+				i++
+
+				continue
+			}
+		}
+
+		builder.WriteByte(text[i])
+	}
+
+	return builder.String()
+}
+
+// escapeKeyPart escapes the delimiter, brackets and backslashes in a map key
+// so that it can be joined into a path that parses back to the same key.
+func escapeKeyPart(part, delimiter string) string {
+	if !strings.Contains(part, delimiter) && !strings.ContainsAny(part, `\[]`) {
+		return part
+	}
+
+	var builder strings.Builder
+
+	for i := 0; i < len(part); i++ {
+		if strings.HasPrefix(part[i:], delimiter) {
+			builder.WriteString(`\` + delimiter)
+			i += len(delimiter) - 1
+
+			continue
+		}
+
+		if strings.IndexByte(`\[]`, part[i]) >= 0 {
+			builder.WriteByte('\\')
+		}
+
+		builder.WriteByte(part[i])
+	}
+
+	return builder.String()
+}
+
+// resolveIndex converts a possibly negative index into a position in a slice of the given length.
+// The result may equal length; callers decide whether that is in range.
+func resolveIndex(index, length int) (int, bool) {
+	if index < 0 {
+		index += length
+	}
+
+	return index, index >= 0 && index <= length
+}
+
+// lookupUnsafe retrieves a value by key.
+// A flat key takes priority; keys containing the delimiter, brackets or escapes fall back to nested access.
+// This method assumes the caller holds the appropriate lock.
+func (c *Config) lookupUnsafe(key string) (any, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	data := c.dataUnsafe()
+
+	// Try flat key first
+	if flatKey, exists := mapKey(data, key, c.caseInsensitive); exists {
+		return data[flatKey], true
+	}
+
+	// If flat key doesn't exist and key is a path, try nested access
+	if c.isPathKey(key) {
+		return c.getNestedValueUnsafe(key)
 	}
 
 	return nil, false
 }
 
+// getNestedValueUnsafe retrieves a nested value using dot notation (e.g., "server.host")
+// and slice indexes (e.g., "upstreams[1].host", "upstreams.1.host", "upstreams[-1]").
+// This method assumes the caller holds the appropriate lock.
+func (c *Config) getNestedValueUnsafe(key string) (any, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	var current any = c.dataUnsafe()
+
+	for _, segment := range c.parseKeyPath(key) {
+		child, exists := childValue(current, segment, c.caseInsensitive)
+		if !exists {
+			return nil, false
+		}
+
+		current = child
+	}
+
+	return current, true
+}
+
+// childValue returns the value addressed by segment inside a map or slice node.
+// Map keys are matched case-insensitively if fold is set.
+func childValue(node any, segment pathSegment, fold bool) (any, bool) {
+	if nestedMap, ok := node.(map[string]any); ok {
+		if segment.isIndex {
+			return nil, false
+		}
+
+		key, exists := mapKey(nestedMap, segment.key, fold)
+
+		return nestedMap[key], exists
+	}
+
+	if !segment.isIndex && !segment.numeric {
+		return nil, false
+	}
+
+	list := reflect.ValueOf(node)
+	if list.Kind() != reflect.Slice && list.Kind() != reflect.Array {
+		return nil, false
+	}
+
+	index, ok := resolveIndex(segment.index, list.Len())
+	if !ok || index == list.Len() {
+		return nil, false
+	}
+
+	return list.Index(index).Interface(), true
+}
+
+// mapKey returns the key under which m stores key and whether it exists.
+// If fold is set and there is no exact match, keys are matched case-insensitively.
+func mapKey(m map[string]any, key string, fold bool) (string, bool) {
+	if _, exists := m[key]; exists || !fold {
+		return key, exists
+	}
+
+	for existing := range m {
+		if strings.EqualFold(existing, key) {
+			return existing, true
+		}
+	}
+
+	return key, false
+}
+
+// findKeyCollision reports the first pair of keys in the same map that differ only by case,
+// searching nested maps and lists. Keys are reported in dotted form below prefix.
+func findKeyCollision(value any, prefix string) error {
+	switch v := value.(type) {
+	case map[string]any:
+		seen := make(map[string]string, len(v))
+
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			folded := strings.ToLower(key)
+			if other, exists := seen[folded]; exists {
+				return fmt.Errorf("%w: %q and %q", ErrKeyCollision, joinPath(prefix, other), joinPath(prefix, key))
+			}
+
+			seen[folded] = key
+
+			if err := findKeyCollision(v[key], joinPath(prefix, key)); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, item := range v {
+			if err := findKeyCollision(item, indexKeyPath(prefix, i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkKeyCollisions reports keys of data that differ only by case when keys are case-insensitive.
+// It only reads options fixed at construction, so no lock is required.
+func (c *Config) checkKeyCollisions(data map[string]any) error {
+	if !c.caseInsensitive {
+		return nil
+	}
+
+	return findKeyCollision(data, "")
+}
+
 // hasNestedKeyUnsafe checks if a nested key exists using dot notation.
 // This method assumes the caller holds the appropriate lock.
 func (c *Config) hasNestedKeyUnsafe(key string) bool {
@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | nested_test.go
 	::  ::          ::  ::    Created  | 2025-08-19
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -17,6 +17,8 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -185,6 +187,211 @@ func TestNested_EdgeCases(t *testing.T) {
 	assert.Equal(t, "dot_value", value)
 }
 
+// TestParseKeyPath tests splitting keys into map and index segments
+func TestParseKeyPath(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	tests := []struct {
+		key      string
+		expected []pathSegment
+	}{
+		{"server.host", []pathSegment{{key: "server"}, {key: "host"}}},
+		{"upstreams[1].host", []pathSegment{{key: "upstreams"}, {index: 1, isIndex: true}, {key: "host"}}},
+		{"upstreams.1.host", []pathSegment{{key: "upstreams"}, {key: "1", index: 1, numeric: true}, {key: "host"}}},
+		{"upstreams[-1]", []pathSegment{{key: "upstreams"}, {index: -1, isIndex: true}}},
+		{"matrix[0][2]", []pathSegment{{key: "matrix"}, {index: 0, isIndex: true}, {index: 2, isIndex: true}}},
+		{"weird[x].key", []pathSegment{{key: "weird[x]"}, {key: "key"}}},
+		{"open[1", []pathSegment{{key: "open[1"}}},
+		{"a]b[0]", []pathSegment{{key: "a]b[0]"}}},
+		{"key..double", []pathSegment{{key: "key"}, {key: ""}, {key: "double"}}},
+		{`hosts.api\.example\.com.port`, []pathSegment{{key: "hosts"}, {key: "api.example.com"}, {key: "port"}}},
+		{`hosts["api.example.com"].port`, []pathSegment{{key: "hosts"}, {key: "api.example.com"}, {key: "port"}}},
+		{`hosts['a.b'][0]`, []pathSegment{{key: "hosts"}, {key: "a.b"}, {index: 0, isIndex: true}}},
+		{`hosts["say \"hi\"."]`, []pathSegment{{key: "hosts"}, {key: `say "hi".`}}},
+		{`["1"].x`, []pathSegment{{key: "1"}, {key: "x"}}},
+		{`a\[0\]`, []pathSegment{{key: "a[0]"}}},
+		{`path\\.x`, []pathSegment{{key: `path\`}, {key: "x"}}},
+		{`win\dir`, []pathSegment{{key: `win\dir`}}},
+		{`a\1.b`, []pathSegment{{key: `a\1`}, {key: "b"}}},
+		{`shards.\1`, []pathSegment{{key: "shards"}, {key: `\1`}}},
+		{`broken["x].y`, []pathSegment{{key: `broken["x].y`}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.expected, c.parseKeyPath(tt.key))
+		})
+	}
+}
+
+// TestNested_ArrayIndexes tests addressing slice elements in keys
+func TestNested_ArrayIndexes(t *testing.T) {
+	newConfig := func(t *testing.T) *Config {
+		t.Helper()
+
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{
+			"upstreams": []any{
+				map[string]any{"host": "a.example.com", "port": 80},
+				map[string]any{"host": "b.example.com", "port": 8080, "tags": []string{"primary", "eu"}},
+			},
+			"matrix": []any{[]any{1, 2}, []any{3, 4}},
+			"ports":  []int{80, 443},
+			"labels": map[string]any{"0": "zero"},
+		})
+
+		return c
+	}
+
+	t.Run("Get", func(t *testing.T) {
+		c := newConfig(t)
+
+		assert.Equal(t, "b.example.com", c.GetString("upstreams[1].host"))
+		assert.Equal(t, "b.example.com", c.GetString("upstreams.1.host"))
+		assert.Equal(t, 80, c.GetInt("upstreams[0].port"))
+		assert.Equal(t, "b.example.com", c.GetString("upstreams[-1].host"))
+		assert.Equal(t, "a.example.com", c.GetString("upstreams[-2].host"))
+		assert.Equal(t, "eu", c.GetString("upstreams[1].tags[1]"))
+		assert.Equal(t, "eu", c.GetString("upstreams.-1.tags.-1"))
+		assert.Equal(t, 3, c.GetInt("matrix[1][0]"))
+		assert.Equal(t, 443, c.GetInt("ports[1]"))
+		assert.Equal(t, "zero", c.GetString("labels.0"))
+		assert.Equal(t, []int{80, 443}, c.GetIntSlice("ports"))
+
+		_, err := c.GetStringE("upstreams[2].host")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("Has", func(t *testing.T) {
+		c := newConfig(t)
+
+		assert.True(t, c.Has("upstreams[0]"))
+		assert.True(t, c.Has("upstreams.1.port"))
+		assert.True(t, c.Has("upstreams[-2].host"))
+		assert.False(t, c.Has("upstreams[2]"))
+		assert.False(t, c.Has("upstreams[-3]"))
+		assert.False(t, c.Has("upstreams[0].missing"))
+		assert.False(t, c.Has("labels[0]"), "brackets only address slices")
+		assert.False(t, c.Has("upstreams.first"))
+		assert.False(t, c.Has("ports[0].nested"))
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		c := newConfig(t)
+
+		c.Set("upstreams[0].host", "c.example.com")
+		c.Set("upstreams.1.port", 9090)
+		c.Set("upstreams[-1].weight", 5)
+		assert.Equal(t, "c.example.com", c.GetString("upstreams[0].host"))
+		assert.Equal(t, 9090, c.GetInt("upstreams[1].port"))
+		assert.Equal(t, 5, c.GetInt("upstreams[1].weight"))
+
+		// An index one past the end appends, further indexes are ignored
+		c.Set("upstreams[2].host", "d.example.com")
+		c.Set("upstreams[5].host", "ignored")
+		c.Set("upstreams[-9].host", "ignored")
+		assert.Equal(t, "d.example.com", c.GetString("upstreams[-1].host"))
+		assert.Len(t, c.GetStringMap("upstreams[2]"), 1)
+		assert.False(t, c.Has("upstreams[3]"))
+
+		c.Set("matrix[0][1]", 20)
+		assert.Equal(t, 20, c.GetInt("matrix[0][1]"))
+
+		// Typed slices become []any when an element is set
+		c.Set("ports[2]", 8443)
+		assert.Equal(t, []int{80, 443, 8443}, c.GetIntSlice("ports"))
+
+		// Dotted integers on maps stay map keys
+		c.Set("labels.1", "one")
+		assert.Equal(t, "one", c.GetNestedMap("labels")["1"])
+	})
+
+	t.Run("SetCreatesSlices", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		c.Set("servers[0].host", "localhost")
+		c.Set("servers[1].host", "remote")
+		c.Set("grid[0][0]", "x")
+		c.Set("missing[3]", "ignored")
+
+		assert.Equal(t, "localhost", c.GetString("servers[0].host"))
+		assert.Equal(t, "remote", c.GetString("servers.1.host"))
+		assert.Equal(t, "x", c.GetString("grid[0][0]"))
+		assert.False(t, c.Has("missing"))
+
+		// Without brackets, new integer parts create maps
+		c.Set("shards.0.host", "db0")
+		assert.Equal(t, "db0", c.GetNestedMap("shards.0")["host"])
+	})
+
+	t.Run("RequiredKeys", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "config.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"upstreams": [{"host": "a"}]}`), 0o600))
+
+		c, err := New()
+		require.NoError(t, err)
+		require.NoError(t, c.LoadFromFile(path, &LoadOptions{
+			IgnoreEnv:    true,
+			RequiredKeys: []string{"upstreams[0].host", "upstreams.-1.host"},
+		}))
+
+		err = c.LoadFromFile(path, &LoadOptions{IgnoreEnv: true, RequiredKeys: []string{"upstreams[1].host"}})
+		require.ErrorIs(t, err, ErrRequiredKeyMissing)
+		assert.Contains(t, err.Error(), "upstreams[1].host")
+	})
+}
+
+// TestNested_EscapedKeys tests keys that contain the delimiter
+func TestNested_EscapedKeys(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"hosts": map[string]any{
+			"api.example.com": map[string]any{"port": 443},
+			"localhost":       map[string]any{"port": 8080},
+		},
+		"weights": map[string]any{"a[1]": 5},
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		assert.Equal(t, 443, c.GetInt(`hosts.api\.example\.com.port`))
+		assert.Equal(t, 443, c.GetInt(`hosts["api.example.com"].port`))
+		assert.Equal(t, 443, c.GetInt(`hosts['api.example.com'].port`))
+		assert.Equal(t, 5, c.GetInt(`weights.a\[1\]`))
+		assert.Equal(t, 5, c.GetInt(`weights["a[1]"]`))
+		assert.False(t, c.Has("hosts.api.example.com.port"))
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		c.Set(`hosts["cdn.example.com"].port`, 80)
+		c.Set(`hosts.api\.example\.com.tls`, true)
+
+		assert.Equal(t, 80, c.GetNestedMap("hosts")["cdn.example.com"].(map[string]any)["port"])
+		assert.True(t, c.GetBool(`hosts["api.example.com"].tls`))
+
+		// A flat key can be stored with escaped dots and read back either way
+		c.Set(`log\.level`, "debug")
+		assert.Equal(t, "debug", c.GetString(`log\.level`))
+		assert.Equal(t, "debug", c.GetString("log.level"))
+	})
+
+	t.Run("GetNestedKeys escapes parts", func(t *testing.T) {
+		keys := c.GetNestedKeys("hosts")
+		assert.Contains(t, keys, `hosts.api\.example\.com`)
+		assert.Contains(t, keys, "hosts.localhost")
+
+		for _, key := range keys {
+			assert.True(t, c.Has(key), key)
+		}
+	})
+}
+
 // Benchmark Tests for nested.go functions
 
 func BenchmarkConfig_GetNestedValueUnsafe(b *testing.B) {
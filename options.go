@@ -0,0 +1,64 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | options.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WithKeyDelimiter sets the separator of nested key parts, "." by default.
+// Use it when keys naturally contain dots, e.g. WithKeyDelimiter("::") for "hosts::api.example.com::port".
+// The delimiter cannot contain brackets, quotes or backslashes, which are used for indexes and escaping.
+func WithKeyDelimiter(delimiter string) Option {
+	return func(c *Config) error {
+		if delimiter == "" || strings.ContainsAny(delimiter, keyEscapes) {
+			return fmt.Errorf("%w: unsupported key delimiter %q", ErrInvalidKey, delimiter)
+		}
+
+		c.keyDelimiter = delimiter
+
+		return nil
+	}
+}
+
+// WithTimeLayouts adds time layouts (see time.Parse) tried by GetTime
+// before the built-in RFC 3339, date-time and date-only layouts.
+func WithTimeLayouts(layouts ...string) Option {
+	return func(c *Config) error {
+		for _, layout := range layouts {
+			if layout == "" {
+				return fmt.Errorf("%w: empty time layout", ErrInvalidFormat)
+			}
+		}
+
+		c.timeLayouts = append(c.timeLayouts, layouts...)
+
+		return nil
+	}
+}
+
+// WithCaseInsensitiveKeys makes key lookups ignore case, so "Server.Port" and "server.port" address the same value.
+// Keys keep their original spelling in GetAll and Keys. Loading data whose keys differ only by case
+// fails with ErrKeyCollision; LoadFromMap does not check, LoadFromMapE does.
+func WithCaseInsensitiveKeys() Option {
+	return func(c *Config) error {
+		c.caseInsensitive = true
+
+		return nil
+	}
+}
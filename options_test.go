@@ -0,0 +1,227 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | options_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOptions_WithTimeLayouts tests the WithTimeLayouts option
+func TestOptions_WithTimeLayouts(t *testing.T) {
+	t.Run("appends layouts", func(t *testing.T) {
+		c, err := New(WithTimeLayouts(time.RFC1123), WithTimeLayouts("2006/01/02"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{time.RFC1123, "2006/01/02"}, c.timeLayouts)
+	})
+
+	t.Run("parses day-first dates", func(t *testing.T) {
+		c, err := New(WithTimeLayouts("02/01/2006"))
+		require.NoError(t, err)
+		c.Set("date", "03/04/2026")
+
+		assert.Equal(t, time.Date(2026, time.April, 3, 0, 0, 0, 0, time.UTC), c.GetTime("date"))
+	})
+
+	t.Run("rejects empty layout", func(t *testing.T) {
+		_, err := New(WithTimeLayouts(""))
+		assert.ErrorIs(t, err, ErrInvalidFormat)
+	})
+}
+
+// TestOptions_WithKeyDelimiter tests the WithKeyDelimiter option
+func TestOptions_WithKeyDelimiter(t *testing.T) {
+	t.Run("uses custom delimiter", func(t *testing.T) {
+		c, err := New(WithKeyDelimiter("::"))
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{
+			"hosts": map[string]any{
+				"api.example.com": map[string]any{"port": 443},
+			},
+			"upstreams": []any{map[string]any{"host": "a"}},
+		})
+
+		assert.Equal(t, 443, c.GetInt("hosts::api.example.com::port"))
+		assert.Equal(t, "a", c.GetString("upstreams[0]::host"))
+		assert.Equal(t, "a", c.GetString("upstreams::0::host"))
+		assert.False(t, c.Has("hosts.api"))
+
+		c.Set("hosts::cdn.example.com::port", 80)
+		assert.Equal(t, 80, c.GetInt(`hosts["cdn.example.com"]::port`))
+		assert.Contains(t, c.GetNestedKeys("hosts"), "hosts::cdn.example.com")
+
+		c.Set(`ratio\::x`, 1)
+		assert.True(t, c.Has("ratio::x"), "escaped delimiter is stored as a flat key")
+	})
+
+	t.Run("applies to defaults and required keys", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "config.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"db": {"primary.eu": {"host": "x"}}}`), 0o600))
+
+		c, err := New(WithKeyDelimiter("/"))
+		require.NoError(t, err)
+		require.NoError(t, c.LoadFromFile(path, &LoadOptions{
+			IgnoreEnv:     true,
+			RequiredKeys:  []string{"db/primary.eu/host"},
+			DefaultValues: map[string]any{"db/primary.eu/port": 5432},
+		}))
+
+		assert.Equal(t, 5432, c.GetInt("db/primary.eu/port"))
+	})
+
+	t.Run("rejects reserved characters", func(t *testing.T) {
+		for _, delimiter := range []string{"", "[", "]", `\`, `"`, "'"} {
+			_, err := New(WithKeyDelimiter(delimiter))
+			assert.ErrorIs(t, err, ErrInvalidKey, delimiter)
+		}
+	})
+}
+
+// TestOptions_WithCaseInsensitiveKeys tests the WithCaseInsensitiveKeys option
+func TestOptions_WithCaseInsensitiveKeys(t *testing.T) {
+	writeFile := func(t *testing.T, name, content string) string {
+		t.Helper()
+
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		return path
+	}
+
+	t.Run("lookups ignore case in all formats", func(t *testing.T) {
+		files := map[string]string{
+			"config.json": `{"Server": {"Port": 8080, "Hosts": [{"Name": "a"}]}}`,
+			"config.yaml": "Server:\n  Port: 8080\n  Hosts:\n    - Name: a\n",
+			"config.ini":  "[Server]\nPort = 8080\n",
+		}
+
+		for name, content := range files {
+			c, err := New(WithCaseInsensitiveKeys())
+			require.NoError(t, err)
+			require.NoError(t, c.LoadFromFile(writeFile(t, name, content), &LoadOptions{IgnoreEnv: true}), name)
+
+			assert.Equal(t, 8080, c.GetInt("server.port"), name)
+			assert.Equal(t, 8080, c.GetInt("SERVER.PORT"), name)
+			assert.True(t, c.Has("sErVeR"), name)
+			assert.Equal(t, []string{"Server"}, c.Keys(), name)
+
+			if name != "config.ini" {
+				assert.Equal(t, "a", c.GetString("server.hosts[0].name"), name)
+			}
+		}
+	})
+
+	t.Run("keeps original spelling", func(t *testing.T) {
+		c, err := New(WithCaseInsensitiveKeys())
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{"Server": map[string]any{"Port": 8080}, "LogLevel": "info"})
+		c.Set("server.port", 9090)
+		c.Set("server.TLS", true)
+		c.Set("loglevel", "debug")
+		c.LoadFromMap(map[string]any{"LOGLEVEL": "warn"})
+
+		assert.Equal(t, map[string]any{
+			"Server":   map[string]any{"Port": 9090, "TLS": true},
+			"LogLevel": "warn",
+		}, c.GetAll())
+	})
+
+	t.Run("defaults, required keys and unmarshal", func(t *testing.T) {
+		c, err := New(WithCaseInsensitiveKeys())
+		require.NoError(t, err)
+
+		path := writeFile(t, "config.yaml", "Database:\n  Host: db\n")
+		require.NoError(t, c.LoadFromFile(path, &LoadOptions{
+			IgnoreEnv:     true,
+			RequiredKeys:  []string{"database.host"},
+			DefaultValues: map[string]any{"database.host": "localhost", "database.port": 5432},
+		}))
+
+		assert.Equal(t, "db", c.GetString("database.host"))
+		assert.Equal(t, map[string]any{"Host": "db", "port": 5432}, c.GetNestedMap("Database"))
+
+		var db struct {
+			Host string `config:"host"`
+			Port int    `config:"port"`
+		}
+		require.NoError(t, c.UnmarshalKey("database", &db))
+		assert.Equal(t, "db", db.Host)
+		assert.Equal(t, 5432, db.Port)
+	})
+
+	t.Run("merges keys that differ by case", func(t *testing.T) {
+		c, err := New(WithCaseInsensitiveKeys())
+		require.NoError(t, err)
+
+		base := writeFile(t, "base.json", `{"Server": {"Host": "localhost", "Port": 80}}`)
+		override := writeFile(t, "override.json", `{"server": {"port": 8080}}`)
+		require.NoError(t, c.LoadFromFiles([]string{base, override}, &LoadOptions{IgnoreEnv: true}))
+
+		assert.Equal(t, map[string]any{"Server": map[string]any{"Host": "localhost", "Port": float64(8080)}}, c.GetAll())
+
+		require.NoError(t, c.MergeMap(map[string]any{"SERVER": map[string]any{"HOST": "example.com"}}, MergeStrategy{}))
+		assert.Equal(t, "example.com", c.GetString("Server.Host"))
+		assert.Len(t, c.GetNestedMap("server"), 2)
+	})
+
+	t.Run("reports collisions", func(t *testing.T) {
+		c, err := New(WithCaseInsensitiveKeys())
+		require.NoError(t, err)
+
+		path := writeFile(t, "config.json", `{"db": {"hosts": [{"Port": 1, "port": 2}]}}`)
+		err = c.LoadFromFile(path, &LoadOptions{IgnoreEnv: true})
+		require.ErrorIs(t, err, ErrKeyCollision)
+		assert.Contains(t, err.Error(), `"db.hosts[0].Port" and "db.hosts[0].port"`)
+
+		err = c.LoadFromFiles([]string{path}, &LoadOptions{IgnoreEnv: true})
+		require.ErrorIs(t, err, ErrKeyCollision)
+
+		err = c.MergeMap(map[string]any{"Key": 1, "KEY": 2}, MergeStrategy{})
+		require.ErrorIs(t, err, ErrKeyCollision)
+		assert.False(t, c.Has("key"), "configuration is unchanged on collision")
+
+		err = c.LoadFromMapE(map[string]any{"Port": 1, "port": 2})
+		require.ErrorIs(t, err, ErrKeyCollision)
+		assert.False(t, c.Has("port"), "configuration is unchanged on collision")
+
+		err = c.LoadFromMapE(map[string]any{"Server": map[string]any{"Port": 1, "port": 2}})
+		require.ErrorIs(t, err, ErrKeyCollision)
+		assert.Contains(t, err.Error(), `"Server.Port" and "Server.port"`)
+		assert.False(t, c.Has("server"))
+
+		require.NoError(t, c.LoadFromMapE(map[string]any{"Server": map[string]any{"Port": 1}}))
+		assert.Equal(t, 1, c.GetInt("server.PORT"))
+	})
+
+	t.Run("case-sensitive by default", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{"Server": map[string]any{"Port": 8080}, "server": "other"})
+		assert.Equal(t, 8080, c.GetInt("Server.Port"))
+		assert.False(t, c.Has("server.port"))
+		assert.False(t, c.Has("SERVER"))
+	})
+}
@@ -0,0 +1,258 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | properties_parser.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// propertiesWhitespace holds the characters java.util.Properties treats as whitespace.
+const propertiesWhitespace = " \t\f"
+
+// parseProperties parses Java properties content following the java.util.Properties rules:
+// "#" and "!" comments, "=", ":" or whitespace separators, backslash escapes including
+// \uXXXX, and line continuations whose leading whitespace is stripped.
+// Content that is not valid UTF-8 is read as ISO-8859-1. Values are kept as strings.
+//
+// Dotted names are mapped into nested maps ("server.port" becomes server -> port).
+// When a name is also the prefix of another one ("log.level" and "log.level.root"),
+// the shorter keeps its value and the longer is stored as a flat key, which lookups try first.
+func parseProperties(content string) (map[string]any, error) {
+	if !utf8.ValidString(content) {
+		content = decodeLatin1(content)
+	}
+
+	content = strings.TrimPrefix(content, "\ufeff")
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "\r", "\n")
+
+	lines := strings.Split(content, "\n")
+	properties := make(map[string]string)
+
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimLeft(lines[i], propertiesWhitespace)
+
+		// Skip blank lines and comments, which are never continued
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+
+		// Join continuation lines, dropping their leading whitespace
+		for hasPropertiesContinuation(line) {
+			line = line[:len(line)-1]
+
+			if i+1 == len(lines) {
+				break
+			}
+
+			i++
+			line += strings.TrimLeft(lines[i], propertiesWhitespace)
+		}
+
+		key, value, err := splitProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		// Later definitions replace earlier ones
+		properties[key] = value
+	}
+
+	return nestProperties(properties), nil
+}
+
+// splitProperty splits a logical line into its unescaped key and value.
+// The key ends at the first unescaped "=", ":" or whitespace; whitespace around
+// the separator and a single "=" or ":" after whitespace are skipped.
+func splitProperty(line string) (string, string, error) {
+	end := 0
+
+	for end < len(line) && !strings.ContainsRune("=:"+propertiesWhitespace, rune(line[end])) {
+		if line[end] == '\\' {
+			end++
+		}
+
+		end++
+	}
+
+	end = min(end, len(line))
+
+	rest := strings.TrimLeft(line[end:], propertiesWhitespace)
+	if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+		rest = strings.TrimLeft(rest[1:], propertiesWhitespace)
+	}
+
+	key, err := unescapeProperty(line[:end])
+	if err != nil {
+		return "", "", err
+	}
+
+	value, err := unescapeProperty(rest)
+	if err != nil {
+		return "", "", err
+	}
+
+	return key, value, nil
+}
+
+// unescapeProperty decodes \t, \n, \r, \f and \uXXXX escapes; any other escaped
+// character stands for itself. UTF-16 surrogate pairs are combined.
+func unescapeProperty(value string) (string, error) {
+	if !strings.Contains(value, `\`) {
+		return value, nil
+	}
+
+	var builder strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' {
+			builder.WriteByte(value[i])
+
+			continue
+		}
+
+		i++
+		if i == len(value) {
+			break
+		}
+
+		switch value[i] {
+		case 't':
+			builder.WriteByte('\t')
+		case 'n':
+			builder.WriteByte('\n')
+		case 'r':
+			builder.WriteByte('\r')
+		case 'f':
+			builder.WriteByte('\f')
+		case 'u':
+			decoded, err := parsePropertiesUnicode(value[i+1:])
+			if err != nil {
+				return "", err
+			}
+
+			i += 4
+
+			// A high surrogate followed by an escaped low surrogate encodes one character
+			if utf16.IsSurrogate(decoded) && strings.HasPrefix(value[i+1:], `\u`) {
+				if low, err := parsePropertiesUnicode(value[i+3:]); err == nil {
+					if combined := utf16.DecodeRune(decoded, low); combined != utf8.RuneError {
+						decoded = combined
+						i += 6
+					}
+				}
+			}
+
+			builder.WriteRune(decoded)
+		default:
+			builder.WriteByte(value[i])
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// parsePropertiesUnicode decodes the four hex digits at the start of digits.
+func parsePropertiesUnicode(digits string) (rune, error) {
+	if len(digits) < 4 {
+		return 0, errors.New(`malformed \uxxxx encoding`)
+	}
+
+	code, err := strconv.ParseUint(digits[:4], 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf(`malformed \uxxxx encoding: \u%s`, digits[:4])
+	}
+
+	return rune(code), nil
+}
+
+// hasPropertiesContinuation reports whether line ends with an odd number of backslashes.
+func hasPropertiesContinuation(line string) bool {
+	count := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		count++
+	}
+
+	return count%2 == 1
+}
+
+// nestProperties maps dotted property names into nested maps.
+// Names are processed in sorted order so that a prefix is always placed before longer names.
+func nestProperties(properties map[string]string) map[string]any {
+	result := make(map[string]any, len(properties))
+
+	for _, name := range slices.Sorted(maps.Keys(properties)) {
+		if !setPropertyPath(result, strings.Split(name, "."), properties[name]) {
+			result[name] = properties[name]
+		}
+	}
+
+	return result
+}
+
+// setPropertyPath stores value at the path of parts, creating maps as needed.
+// It reports false if a part is empty or the path runs into an existing value.
+func setPropertyPath(node map[string]any, parts []string, value string) bool {
+	if slices.Contains(parts, "") {
+		return false
+	}
+
+	for _, part := range parts[:len(parts)-1] {
+		existing, exists := node[part]
+		if !exists {
+			child := make(map[string]any)
+			node[part] = child
+			node = child
+
+			continue
+		}
+
+		child, isMap := existing.(map[string]any)
+		if !isMap {
+			return false
+		}
+
+		node = child
+	}
+
+	last := parts[len(parts)-1]
+	if _, exists := node[last]; exists {
+		return false
+	}
+
+	node[last] = value
+
+	return true
+}
+
+// decodeLatin1 converts ISO-8859-1 encoded content to UTF-8.
+func decodeLatin1(content string) string {
+	runes := make([]rune, len(content))
+	for i := 0; i < len(content); i++ {
+		runes[i] = rune(content[i])
+	}
+
+	return string(runes)
+}
@@ -0,0 +1,227 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | properties_parser_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPropertiesParser_Comprehensive tests properties parsing with extensive coverage
+func TestPropertiesParser_Comprehensive(t *testing.T) {
+	t.Run("Separators", func(t *testing.T) {
+		result, err := parseProperties(`
+equals=1
+colon:2
+spaced = 3
+  indented : 4
+whitespace    5
+tab	6
+double==7
+mixed = :8
+empty=
+bare
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"equals":     "1",
+			"colon":      "2",
+			"spaced":     "3",
+			"indented":   "4",
+			"whitespace": "5",
+			"tab":        "6",
+			"double":     "=7",
+			"mixed":      ":8",
+			"empty":      "",
+			"bare":       "",
+		}, result)
+	})
+
+	t.Run("Comments", func(t *testing.T) {
+		result, err := parseProperties("# hash comment\n! bang comment\n   # indented comment \\\nkey = value # not a comment\n")
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{"key": "value # not a comment"}, result)
+	})
+
+	t.Run("Continuations", func(t *testing.T) {
+		result, err := parseProperties(`
+fruits                           apple, banana, pear, \
+                                 cantaloupe, watermelon, \
+                                 kiwi, mango
+path = C:\\temp\\
+next = value
+multi = first \
+    # not a comment \
+    last
+dangling = end\`)
+		require.NoError(t, err)
+
+		assert.Equal(t, "apple, banana, pear, cantaloupe, watermelon, kiwi, mango", result["fruits"])
+		assert.Equal(t, `C:\temp\`, result["path"])
+		assert.Equal(t, "value", result["next"])
+		assert.Equal(t, "first # not a comment last", result["multi"])
+		assert.Equal(t, "end", result["dangling"])
+	})
+
+	t.Run("Escapes", func(t *testing.T) {
+		result, err := parseProperties(`
+key\ with\ spaces = value
+key\=with\:separators = value
+tabs = a\tb\nc\rd\fe
+unicode = caf\u00e9 \u2603
+pair = \uD83D\uDE00
+other = \q\#\!
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, "value", result["key with spaces"])
+		assert.Equal(t, "value", result["key=with:separators"])
+		assert.Equal(t, "a\tb\nc\rd\fe", result["tabs"])
+		assert.Equal(t, "café ☃", result["unicode"])
+		assert.Equal(t, "😀", result["pair"])
+		assert.Equal(t, "q#!", result["other"])
+
+		// Trailing whitespace belongs to the value
+		result, err = parseProperties("trailing = spaces   ")
+		require.NoError(t, err)
+		assert.Equal(t, "spaces   ", result["trailing"])
+	})
+
+	t.Run("LineEndingsAndEncoding", func(t *testing.T) {
+		result, err := parseProperties("a=1\r\nb=2\rc=\\\r\n  3")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"a": "1", "b": "2", "c": "3"}, result)
+
+		result, err = parseProperties("name=caf\xe9")
+		require.NoError(t, err)
+		assert.Equal(t, "café", result["name"])
+
+		result, err = parseProperties("\ufeffname=value")
+		require.NoError(t, err)
+		assert.Equal(t, "value", result["name"])
+	})
+
+	t.Run("NestedNames", func(t *testing.T) {
+		result, err := parseProperties(`
+server.host = localhost
+server.port = 8080
+server.tls.enabled = true
+logging.level = INFO
+logging.level.root = DEBUG
+duplicate = first
+duplicate = second
+odd..name = 1
+.leading = 2
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"host": "localhost",
+			"port": "8080",
+			"tls":  map[string]any{"enabled": "true"},
+		}, result["server"])
+		assert.Equal(t, map[string]any{"level": "INFO"}, result["logging"])
+		assert.Equal(t, "DEBUG", result["logging.level.root"])
+		assert.Equal(t, "second", result["duplicate"])
+		assert.Equal(t, "1", result["odd..name"])
+		assert.Equal(t, "2", result[".leading"])
+	})
+
+	t.Run("MalformedUnicode", func(t *testing.T) {
+		for _, content := range []string{`a=\u12`, `a=\uZZZZ`, "b=1\nc=\\u00g0"} {
+			_, err := parseProperties(content)
+			require.Error(t, err, content)
+			assert.Contains(t, err.Error(), "malformed")
+		}
+
+		_, err := parseProperties("a=1\n\nb=\\u00g0")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 3")
+	})
+}
+
+// TestConfig_LoadFromFile_Properties tests loading properties files through the config API
+func TestConfig_LoadFromFile_Properties(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "application.properties")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+# Service settings
+app.name = billing
+server.port: 8080
+server.timeout = 30s
+server.features = auth,\
+                  metrics
+logging.level = INFO
+logging.level.root = DEBUG
+`), 0o600))
+
+	c, err := New()
+	require.NoError(t, err)
+
+	require.NoError(t, c.LoadFromFile(configPath, &LoadOptions{IgnoreEnv: true}))
+
+	assert.Equal(t, "billing", c.GetString("app.name"))
+	assert.Equal(t, 8080, c.GetInt("server.port"))
+	assert.Equal(t, "30s", c.GetString("server.timeout"))
+	assert.Equal(t, []string{"auth", "metrics"}, c.GetStringSlice("server.features"))
+	assert.Equal(t, "INFO", c.GetString("logging.level"))
+	assert.Equal(t, "DEBUG", c.GetString("logging.level.root"))
+	assert.Equal(t, []string{"server.features", "server.port", "server.timeout"}, c.GetNestedKeys("server"))
+
+	t.Run("detected from extension", func(t *testing.T) {
+		assert.Equal(t, FormatProperties, detectFormat("application.properties"))
+		assert.Equal(t, FormatProperties, detectFormat("APP.PROPERTIES"))
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		badPath := filepath.Join(dir, "bad.properties")
+		require.NoError(t, os.WriteFile(badPath, []byte("a = \\u12"), 0o600))
+
+		err := c.LoadFromFile(badPath, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse properties config")
+		assert.Equal(t, "billing", c.GetString("app.name"))
+	})
+}
+
+// Benchmark Tests for properties_parser.go functions
+
+func BenchmarkParseProperties(b *testing.B) {
+	content := `
+# Service settings
+app.name = billing
+server.host = 0.0.0.0
+server.port = 8080
+server.features = auth, \
+                  metrics
+greeting = caf\u00e9
+`
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := parseProperties(content); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | setters.go
 	::  ::          ::  ::    Created  | 2025-08-19
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -16,87 +16,267 @@
 
 package config
 
-import (
-	"strings"
-)
+import "reflect"
 
 // Set sets a configuration value (useful for runtime configuration changes).
 // Supports both flat keys ("key") and nested keys with dot notation ("server.host").
+// Slice elements are addressed by index ("upstreams[1].host", "upstreams.1.host", "upstreams[-1]");
+// an index one past the end appends to the slice, indexes further out of range are ignored.
 func (c *Config) Set(key string, value any) {
 	if c == nil {
 		return
 	}
 
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	c.locker().Lock()
+	defer c.locker().Unlock()
 
-	// If key contains dots, use nested setting
-	if strings.Contains(key, ".") {
+	c.setUnsafe(key, deepCopy(value))
+}
+
+// setUnsafe sets a flat or nested value.
+// This method assumes the caller holds the write lock.
+func (c *Config) setUnsafe(key string, value any) {
+	// If key is a path, use nested setting
+	if c.isPathKey(key) {
 		c.setNestedValueUnsafe(key, value)
 	} else {
-		c.data[key] = value
+		data := c.writableDataUnsafe()
+		flatKey, _ := mapKey(data, key, c.caseInsensitive)
+		data[flatKey] = value
+	}
+}
+
+// Delete removes a key and reports whether it existed.
+// Supports flat keys ("key"), nested keys with dot notation ("server.host")
+// and slice elements ("upstreams[1]"), which are removed by shifting the following elements.
+// Parent maps left empty are kept, see DeleteAndPrune.
+func (c *Config) Delete(key string) bool {
+	if c == nil {
+		return false
+	}
+
+	c.locker().Lock()
+	defer c.locker().Unlock()
+
+	return c.deleteUnsafe(key, false)
+}
+
+// DeleteAndPrune removes a key like Delete and also removes parent maps left empty,
+// so deleting "features.beta.enabled" from {"features": {"beta": {"enabled": true}}} leaves no "features" key.
+func (c *Config) DeleteAndPrune(key string) bool {
+	if c == nil {
+		return false
+	}
+
+	c.locker().Lock()
+	defer c.locker().Unlock()
+
+	return c.deleteUnsafe(key, true)
+}
+
+// deleteUnsafe removes a flat or nested key, pruning empty parent maps if prune is set.
+// This method assumes the caller holds the write lock.
+func (c *Config) deleteUnsafe(key string, prune bool) bool {
+	data := c.dataUnsafe()
+
+	// A flat key takes priority, as in lookups
+	if flatKey, exists := mapKey(data, key, c.caseInsensitive); exists {
+		delete(data, flatKey)
+
+		return true
+	}
+
+	if !c.isPathKey(key) {
+		return false
 	}
+
+	_, deleted := deletePathValue(data, c.parseKeyPath(key), c.caseInsensitive, prune)
+
+	return deleted
 }
 
+// SetNestedDefaults sets default values for nested keys, only if they don't already exist.
 // This is useful for setting up complex default configurations.
 func (c *Config) SetNestedDefaults(defaults map[string]any) {
 	if c == nil || defaults == nil {
 		return
 	}
 
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	c.locker().Lock()
+	defer c.locker().Unlock()
 
 	for key, value := range defaults {
-		if strings.Contains(key, ".") {
+		if c.isPathKey(key) {
 			// Check if the nested key already exists
 			if !c.hasNestedKeyUnsafe(key) {
-				c.setNestedValueUnsafe(key, value)
+				c.setNestedValueUnsafe(key, deepCopy(value))
 			}
 		} else {
 			// Check if the flat key already exists
-			if _, exists := c.data[key]; !exists {
-				c.data[key] = value
+			if _, exists := mapKey(c.dataUnsafe(), key, c.caseInsensitive); !exists {
+				c.writableDataUnsafe()[key] = deepCopy(value)
 			}
 		}
 	}
 }
 
-// setNestedValueUnsafe sets a nested value using dot notation (e.g., "server.host").
+// setNestedValueUnsafe sets a nested value using dot notation (e.g., "server.host")
+// and slice indexes (e.g., "upstreams[0].host").
+// Returns false if the path addresses a slice index that cannot be set.
 // This method assumes the caller holds the appropriate lock.
-func (c *Config) setNestedValueUnsafe(key string, value any) {
+func (c *Config) setNestedValueUnsafe(key string, value any) bool {
 	if c == nil {
-		return
+		return false
 	}
 
-	parts := strings.Split(key, ".")
-	current := c.data
+	_, ok := setPathValue(c.writableDataUnsafe(), c.parseKeyPath(key), value, c.caseInsensitive)
+
+	return ok
+}
+
+// setPathValue stores value at the path below node and returns the updated node.
+// Containers missing along the path are created and values of the wrong shape are replaced:
+// with maps for key segments and with slices for bracket indexes.
+// Existing map keys are matched case-insensitively if fold is set.
+// Returns the unchanged node and false if an index is out of range.
+func setPathValue(node any, segments []pathSegment, value any, fold bool) (any, bool) {
+	if len(segments) == 0 {
+		return value, true
+	}
 
-	for i, part := range parts {
-		if i == len(parts)-1 {
-			// Last part - set the value
-			current[part] = value
+	segment, rest := segments[0], segments[1:]
 
-			return
+	// Brackets always address a slice, dotted integers only when the node already is one
+	list, isList := toAnyList(node)
+	if segment.isIndex || (isList && segment.numeric) {
+		updated, ok := setListElement(list, segment.index, rest, value, fold)
+		if !ok {
+			return node, false
 		}
 
-		// Navigate or create nested structure
-		if existing, exists := current[part]; exists {
-			if nestedMap, ok := existing.(map[string]any); ok {
-				current = nestedMap
-			} else {
-				// Existing value is not a map, replace it with a map
-				current[part] = make(map[string]any)
-				if m, ok := current[part].(map[string]any); ok {
-					current = m
-				}
-			}
+		return updated, true
+	}
+
+	nestedMap, ok := node.(map[string]any)
+	if !ok {
+		// Existing value is not a map, replace it with a map
+		nestedMap = make(map[string]any)
+	}
+
+	key, _ := mapKey(nestedMap, segment.key, fold)
+
+	child, ok := setPathValue(nestedMap[key], rest, value, fold)
+	if !ok {
+		return node, false
+	}
+
+	nestedMap[key] = child
+
+	return nestedMap, true
+}
+
+// setListElement stores value at the path below list[index], appending if index equals the length.
+func setListElement(list []any, index int, rest []pathSegment, value any, fold bool) ([]any, bool) {
+	position, ok := resolveIndex(index, len(list))
+	if !ok {
+		return nil, false
+	}
+
+	var current any
+	if position < len(list) {
+		current = list[position]
+	}
+
+	child, ok := setPathValue(current, rest, value, fold)
+	if !ok {
+		return nil, false
+	}
+
+	if position == len(list) {
+		return append(list, child), true
+	}
+
+	list[position] = child
+
+	return list, true
+}
+
+// deletePathValue removes the value at the path below node and returns the updated node.
+// Removing a slice element creates a new slice of the same type. If prune is set, maps left empty are
+// removed from their parent maps. Returns the unchanged node and false if the path does not exist.
+func deletePathValue(node any, segments []pathSegment, fold, prune bool) (any, bool) {
+	segment, rest := segments[0], segments[1:]
+
+	if nestedMap, ok := node.(map[string]any); ok {
+		key, exists := mapKey(nestedMap, segment.key, fold)
+		if segment.isIndex || !exists {
+			return node, false
+		}
+
+		if len(rest) == 0 {
+			delete(nestedMap, key)
+
+			return nestedMap, true
+		}
+
+		child, deleted := deletePathValue(nestedMap[key], rest, fold, prune)
+		if !deleted {
+			return node, false
+		}
+
+		if childMap, isMap := child.(map[string]any); prune && isMap && len(childMap) == 0 {
+			delete(nestedMap, key)
 		} else {
-			// Create new nested map
-			current[part] = make(map[string]any)
-			if m, ok := current[part].(map[string]any); ok {
-				current = m
-			}
+			nestedMap[key] = child
 		}
+
+		return nestedMap, true
 	}
+
+	list := reflect.ValueOf(node)
+	if list.Kind() != reflect.Slice || (!segment.isIndex && !segment.numeric) {
+		return node, false
+	}
+
+	position, ok := resolveIndex(segment.index, list.Len())
+	if !ok || position == list.Len() {
+		return node, false
+	}
+
+	if len(rest) == 0 {
+		// Build a new slice of the same type so callers holding the old one are unaffected
+		result := reflect.MakeSlice(list.Type(), 0, list.Len()-1)
+		result = reflect.AppendSlice(result, list.Slice(0, position))
+		result = reflect.AppendSlice(result, list.Slice(position+1, list.Len()))
+
+		return result.Interface(), true
+	}
+
+	child, deleted := deletePathValue(list.Index(position).Interface(), rest, fold, prune)
+	if !deleted {
+		return node, false
+	}
+
+	list.Index(position).Set(reflect.ValueOf(child))
+
+	return node, true
+}
+
+// toAnyList returns node as a []any, copying typed slices such as []string into a new []any.
+func toAnyList(node any) ([]any, bool) {
+	if list, ok := node.([]any); ok {
+		return list, true
+	}
+
+	value := reflect.ValueOf(node)
+	if value.Kind() != reflect.Slice {
+		return nil, false
+	}
+
+	list := make([]any, value.Len())
+	for i := range list {
+		list[i] = value.Index(i).Interface()
+	}
+
+	return list, true
 }
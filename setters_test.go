@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | setters_test.go
 	::  ::          ::  ::    Created  | 2025-08-19
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -108,6 +108,145 @@ func TestSetNestedDefaults_Comprehensive(t *testing.T) {
 		assert.NotNil(t, c.GetNestedMap("level1.level2"))
 		assert.NotNil(t, c.GetNestedMap("level1.level2.level3"))
 	})
+
+	t.Run("IndexedDefaults", func(t *testing.T) {
+		c.Clear()
+		c.Set("upstreams", []any{map[string]any{"host": "a"}, map[string]any{"host": "b", "port": 9000}})
+
+		c.SetNestedDefaults(map[string]any{
+			"upstreams[0].port": 80,
+			"upstreams[1].port": 80,
+			"upstreams[-1].tls": true,
+		})
+
+		assert.Equal(t, 80, c.GetInt("upstreams[0].port"))
+		assert.Equal(t, 9000, c.GetInt("upstreams[1].port"))
+		assert.True(t, c.GetBool("upstreams[1].tls"))
+	})
+}
+
+// TestDelete_Comprehensive tests removing flat, nested and indexed keys
+func TestDelete_Comprehensive(t *testing.T) {
+	newConfig := func(t *testing.T) *Config {
+		t.Helper()
+
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{
+			"flat":        "value",
+			"log.level":   "debug",
+			"nil_value":   nil,
+			"features":    map[string]any{"beta": map[string]any{"enabled": true}},
+			"server":      map[string]any{"host": "localhost", "port": 8080},
+			"upstreams":   []any{map[string]any{"host": "a"}, map[string]any{"host": "b"}, map[string]any{"host": "c"}},
+			"nested_list": []any{[]any{1, 2}, []any{3}},
+		})
+
+		return c
+	}
+
+	t.Run("flat keys", func(t *testing.T) {
+		c := newConfig(t)
+
+		assert.True(t, c.Delete("flat"))
+		assert.False(t, c.Has("flat"))
+		assert.False(t, c.Delete("flat"))
+
+		// Keys set to nil are removed as well
+		assert.True(t, c.Delete("nil_value"))
+		assert.False(t, c.Has("nil_value"))
+
+		// A flat key containing dots takes priority over the nested path
+		assert.True(t, c.Delete("log.level"))
+		assert.False(t, c.Has("log.level"))
+	})
+
+	t.Run("nested keys", func(t *testing.T) {
+		c := newConfig(t)
+
+		assert.True(t, c.Delete("server.host"))
+		assert.False(t, c.Has("server.host"))
+		assert.Equal(t, 8080, c.GetInt("server.port"))
+		assert.False(t, c.Delete("server.missing"))
+		assert.False(t, c.Delete("server.port.deeper"))
+
+		assert.True(t, c.Delete("features.beta.enabled"))
+		assert.True(t, c.Has("features.beta"), "empty parents are kept without pruning")
+	})
+
+	t.Run("prunes empty parents", func(t *testing.T) {
+		c := newConfig(t)
+
+		assert.True(t, c.DeleteAndPrune("features.beta.enabled"))
+		assert.False(t, c.Has("features"))
+
+		assert.True(t, c.DeleteAndPrune("server.host"))
+		assert.True(t, c.Has("server.port"), "non-empty parents are kept")
+
+		assert.True(t, c.DeleteAndPrune("upstreams[0].host"))
+		assert.Len(t, c.GetStringMap("upstreams[0]"), 0, "list elements are not pruned")
+		assert.Equal(t, "b", c.GetString("upstreams[1].host"))
+		assert.False(t, c.DeleteAndPrune("missing.key"))
+	})
+
+	t.Run("slice elements", func(t *testing.T) {
+		c := newConfig(t)
+
+		assert.True(t, c.Delete("upstreams[1]"))
+		assert.Equal(t, "a", c.GetString("upstreams[0].host"))
+		assert.Equal(t, "c", c.GetString("upstreams[1].host"))
+		assert.False(t, c.Has("upstreams[2]"))
+
+		assert.True(t, c.Delete("upstreams.-1"))
+		assert.Equal(t, "a", c.GetString("upstreams[-1].host"))
+
+		assert.True(t, c.Delete("upstreams[0].host"))
+		assert.True(t, c.Has("upstreams[0]"))
+
+		assert.True(t, c.Delete("nested_list[0][1]"))
+		assert.Equal(t, []int{1}, c.GetIntSlice("nested_list[0]"))
+
+		assert.False(t, c.Delete("upstreams[5]"))
+		assert.False(t, c.Delete("upstreams[-5]"))
+		assert.False(t, c.Delete("server[0]"))
+	})
+
+	t.Run("typed slices keep their type and callers' slices are untouched", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		tags := []string{"x", "y", "z"}
+		c.Set("tags", tags)
+
+		assert.True(t, c.Delete("tags[0]"))
+		assert.Equal(t, []string{"y", "z"}, c.GetStringSlice("tags"))
+		assert.Equal(t, []string{"x", "y", "z"}, tags)
+
+		value, err := Get[any](c, "tags")
+		require.NoError(t, err)
+		assert.IsType(t, []string{}, value)
+	})
+
+	t.Run("case-insensitive keys and views", func(t *testing.T) {
+		c, err := New(WithCaseInsensitiveKeys())
+		require.NoError(t, err)
+
+		c.Set("Server.Host", "localhost")
+		c.Set("Server.Port", 80)
+
+		server := c.Sub("server")
+		assert.True(t, server.Delete("HOST"))
+		assert.False(t, c.Has("server.host"))
+		assert.True(t, server.DeleteAndPrune("port"))
+		assert.True(t, c.Has("server"), "the map of a view is not pruned")
+	})
+
+	t.Run("nil config", func(t *testing.T) {
+		var nilConfig *Config
+		assert.False(t, nilConfig.Delete("key"))
+		assert.False(t, nilConfig.DeleteAndPrune("key"))
+	})
 }
 
 // TestSetters_EdgeCases tests edge cases for setter methods
@@ -0,0 +1,115 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | sub.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import "sync"
+
+// Sub returns a view of the nested map at prefix, e.g. cfg.Sub("database.primary").
+// The view shares the data and lock of the configuration, so changes made through either
+// are visible in both. Keys passed to the view are relative to prefix and it inherits
+// the options of the configuration it was created from.
+// The view is empty while prefix does not hold a map; the first write through the view creates it.
+func (c *Config) Sub(prefix string) *Config {
+	if c == nil {
+		return nil
+	}
+
+	view := &Config{
+		root:            c,
+		prefix:          prefix,
+		keyDelimiter:    c.keyDelimiter,
+		caseInsensitive: c.caseInsensitive,
+		timeLayouts:     c.timeLayouts,
+	}
+
+	// Views of views address the root directly
+	if c.root != nil {
+		view.root = c.root
+
+		switch {
+		case prefix == "":
+			view.prefix = c.prefix
+		case c.prefix != "":
+			view.prefix = c.prefix + c.delimiter() + prefix
+		}
+	}
+
+	return view
+}
+
+// locker returns the lock guarding the data, which views share with their root configuration.
+func (c *Config) locker() *sync.RWMutex {
+	if c.root != nil {
+		return &c.root.mu
+	}
+
+	return &c.mu
+}
+
+// dataUnsafe returns the map holding the keys of the configuration.
+// For a view this is the map at its prefix, or nil if there is none.
+// This method assumes the caller holds the appropriate lock.
+func (c *Config) dataUnsafe() map[string]any {
+	if c.root == nil {
+		return c.data
+	}
+
+	if c.prefix == "" {
+		return c.root.data
+	}
+
+	value, _ := c.root.lookupUnsafe(c.prefix)
+	nestedMap, _ := value.(map[string]any)
+
+	return nestedMap
+}
+
+// writableDataUnsafe returns the map holding the keys of the configuration, creating it for a view.
+// If the prefix of a view cannot hold a map, e.g. an index out of range, the returned map is detached
+// and writes to it are discarded.
+// This method assumes the caller holds the write lock.
+func (c *Config) writableDataUnsafe() map[string]any {
+	if data := c.dataUnsafe(); data != nil || c.root == nil {
+		return data
+	}
+
+	c.root.setUnsafe(c.prefix, make(map[string]any))
+
+	if data := c.dataUnsafe(); data != nil {
+		return data
+	}
+
+	return make(map[string]any)
+}
+
+// replaceDataUnsafe replaces all keys of the configuration with data.
+// This method assumes the caller holds the write lock.
+func (c *Config) replaceDataUnsafe(data map[string]any) {
+	if c.root == nil {
+		c.data = data
+
+		return
+	}
+
+	if c.prefix == "" {
+		c.root.data = data
+
+		return
+	}
+
+	c.root.setUnsafe(c.prefix, data)
+}
@@ -0,0 +1,239 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | sub_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSub_Comprehensive tests configuration views created with Sub
+func TestSub_Comprehensive(t *testing.T) {
+	newConfig := func(t *testing.T, options ...Option) *Config {
+		t.Helper()
+
+		c, err := New(options...)
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{
+			"database": map[string]any{
+				"primary": map[string]any{
+					"host":    "db1",
+					"port":    5432,
+					"timeout": "5s",
+					"pool":    map[string]any{"max_open": 25},
+				},
+				"replicas": []any{map[string]any{"host": "db2"}, map[string]any{"host": "db3"}},
+			},
+			"app": "demo",
+		})
+
+		return c
+	}
+
+	t.Run("getters and Has are relative to the prefix", func(t *testing.T) {
+		c := newConfig(t)
+		primary := c.Sub("database.primary")
+
+		assert.Equal(t, "db1", primary.GetString("host"))
+		assert.Equal(t, 5432, primary.GetInt("port"))
+		assert.Equal(t, "5s", primary.GetDuration("timeout").String())
+		assert.Equal(t, 25, primary.GetInt("pool.max_open"))
+		assert.True(t, primary.Has("pool"))
+		assert.False(t, primary.Has("app"))
+		assert.False(t, primary.Has("database.primary.host"))
+
+		port, err := Get[int](primary, "port")
+		require.NoError(t, err)
+		assert.Equal(t, 5432, port)
+
+		_, err = primary.GetStringE("missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("Keys, Size and GetAll", func(t *testing.T) {
+		c := newConfig(t)
+		primary := c.Sub("database.primary")
+
+		keys := primary.Keys()
+		sort.Strings(keys)
+		assert.Equal(t, []string{"host", "pool", "port", "timeout"}, keys)
+		assert.Equal(t, 4, primary.Size())
+		assert.False(t, primary.IsEmpty())
+		assert.Equal(t, "db1", primary.GetAll()["host"])
+	})
+
+	t.Run("Set writes through to the parent", func(t *testing.T) {
+		c := newConfig(t)
+		primary := c.Sub("database.primary")
+
+		primary.Set("host", "db9")
+		primary.Set("pool.max_idle", 5)
+		assert.Equal(t, "db9", c.GetString("database.primary.host"))
+		assert.Equal(t, 5, c.GetInt("database.primary.pool.max_idle"))
+
+		// Parent changes are visible in the view
+		c.Set("database.primary.port", 6432)
+		assert.Equal(t, 6432, primary.GetInt("port"))
+
+		// Replacing the map at the prefix is visible too
+		c.Set("database.primary", map[string]any{"host": "fresh"})
+		assert.Equal(t, "fresh", primary.GetString("host"))
+		assert.False(t, primary.Has("port"))
+	})
+
+	t.Run("missing prefix", func(t *testing.T) {
+		c := newConfig(t)
+		cache := c.Sub("cache.redis")
+
+		assert.True(t, cache.IsEmpty())
+		assert.Empty(t, cache.Keys())
+		assert.Equal(t, "localhost", cache.GetString("host", "localhost"))
+		assert.False(t, c.Has("cache"), "reading does not create the prefix")
+
+		cache.Set("host", "redis")
+		assert.Equal(t, "redis", c.GetString("cache.redis.host"))
+	})
+
+	t.Run("index prefixes and nested views", func(t *testing.T) {
+		c := newConfig(t)
+
+		assert.Equal(t, "db3", c.Sub("database.replicas[1]").GetString("host"))
+		assert.Equal(t, "db3", c.Sub("database.replicas[-1]").GetString("host"))
+
+		database := c.Sub("database")
+		assert.Equal(t, 25, database.Sub("primary").GetInt("pool.max_open"))
+		assert.Equal(t, 25, database.Sub("primary").Sub("pool").GetInt("max_open"))
+		assert.Equal(t, "db2", database.Sub("replicas[0]").GetString("host"))
+		assert.Equal(t, "db1", database.Sub("").GetString("primary.host"))
+
+		// An index out of range cannot hold a map, so writes are discarded
+		missing := c.Sub("database.replicas[5]")
+		missing.Set("host", "db6")
+		assert.False(t, c.Has("database.replicas[5]"))
+
+		replicas, err := Get[[]any](c, "database.replicas")
+		require.NoError(t, err)
+		assert.Len(t, replicas, 2)
+	})
+
+	t.Run("Clear, LoadFromMap and MergeMap", func(t *testing.T) {
+		c := newConfig(t)
+		primary := c.Sub("database.primary")
+
+		primary.Clear()
+		assert.True(t, primary.IsEmpty())
+		assert.True(t, c.Has("database.primary"))
+		assert.True(t, c.Has("database.replicas"))
+
+		primary.LoadFromMap(map[string]any{"host": "loaded"})
+		require.NoError(t, primary.MergeMap(map[string]any{"pool": map[string]any{"size": 3}}, MergeStrategy{}))
+		assert.Equal(t, map[string]any{"host": "loaded", "pool": map[string]any{"size": 3}}, c.GetNestedMap("database.primary"))
+	})
+
+	t.Run("LoadFromFile loads into the prefix", func(t *testing.T) {
+		c := newConfig(t)
+
+		path := filepath.Join(t.TempDir(), "cache.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"host": "redis", "port": 6379}`), 0o600))
+		require.NoError(t, c.Sub("cache").LoadFromFile(path, &LoadOptions{IgnoreEnv: true, RequiredKeys: []string{"host"}}))
+
+		assert.Equal(t, 6379, c.GetInt("cache.port"))
+		assert.Equal(t, "demo", c.GetString("app"))
+	})
+
+	t.Run("Unmarshal decodes the view", func(t *testing.T) {
+		c := newConfig(t)
+
+		var primary struct {
+			Host string `config:"host"`
+			Port int    `config:"port"`
+		}
+
+		require.NoError(t, c.Sub("database.primary").Unmarshal(&primary))
+		assert.Equal(t, "db1", primary.Host)
+		assert.Equal(t, 5432, primary.Port)
+	})
+
+	t.Run("inherits options", func(t *testing.T) {
+		c := newConfig(t, WithCaseInsensitiveKeys(), WithKeyDelimiter("/"))
+		primary := c.Sub("Database/Primary")
+
+		assert.Equal(t, 25, primary.GetInt("POOL/max_open"))
+		primary.Set("Pool/Max_Open", 30)
+		assert.Equal(t, 30, c.GetInt("database/primary/pool/max_open"))
+	})
+
+	t.Run("nil config", func(t *testing.T) {
+		var nilConfig *Config
+		assert.Nil(t, nilConfig.Sub("any"))
+	})
+}
+
+// TestSub_Concurrency tests that views share the lock of their parent
+func TestSub_Concurrency(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	server := c.Sub("server")
+
+	var wg sync.WaitGroup
+
+	for i := range 50 {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			server.Set("port", i)
+			_ = server.GetInt("port")
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			c.Set("server.host", "localhost")
+			_ = c.GetNestedMap("server")
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, "localhost", server.GetString("host"))
+}
+
+// Benchmark Tests for sub.go functions
+
+func BenchmarkConfig_SubGetString(b *testing.B) {
+	c, err := New()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	c.Set("database.primary.host", "localhost")
+	primary := c.Sub("database.primary")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		primary.GetString("host")
+	}
+}
@@ -0,0 +1,820 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | toml_parser.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// tomlTableKind records how a TOML table was created, which decides how it may be extended.
+type tomlTableKind int
+
+const (
+	tomlExplicitTable tomlTableKind = iota // Defined by a [table] header
+	tomlImplicitTable                      // Created as a parent of a [table] header, may be defined later
+	tomlDottedTable                        // Created by a dotted key, extended only by dotted keys
+	tomlInlineTable                        // Defined inline with { ... }, never extended
+	tomlArrayOfTables                      // Defined by [[table]] headers
+)
+
+// Scalar value patterns of TOML v1.0.
+var (
+	tomlDecimalPattern  = regexp.MustCompile(`^[+-]?(0|[1-9](_?[0-9])*)$`)
+	tomlHexPattern      = regexp.MustCompile(`^0x[0-9A-Fa-f](_?[0-9A-Fa-f])*$`)
+	tomlOctalPattern    = regexp.MustCompile(`^0o[0-7](_?[0-7])*$`)
+	tomlBinaryPattern   = regexp.MustCompile(`^0b[01](_?[01])*$`)
+	tomlFloatPattern    = regexp.MustCompile(`^[+-]?(0|[1-9](_?[0-9])*)(\.[0-9](_?[0-9])*)?([eE][+-]?[0-9](_?[0-9])*)?$`)
+	tomlDateTimePattern = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})` +
+		`(?:[Tt ](\d{2}:\d{2}:\d{2})(\.\d+)?([Zz]|[+-]\d{2}:\d{2})?)?$`)
+	tomlTimePattern = regexp.MustCompile(`^\d{2}:\d{2}:\d{2}(\.\d+)?$`)
+)
+
+// tomlParser holds the state of a single TOML document parse.
+type tomlParser struct {
+	input     string
+	pos       int
+	line      int
+	lineStart int // Offset of the first byte of the current line
+
+	root        map[string]any
+	current     map[string]any           // Table receiving key/value pairs
+	currentPath string                   // Path of current in kinds
+	kinds       map[string]tomlTableKind // How each table was created, by path
+}
+
+// parseTOML parses TOML v1.0 content into nested maps.
+// Integers become int64, floats float64, offset and local date-times and local dates
+// become time.Time (local ones in UTC), and local times are kept as strings.
+func parseTOML(content string) (map[string]any, error) {
+	if !utf8.ValidString(content) {
+		return nil, errors.New("content is not valid UTF-8")
+	}
+
+	p := &tomlParser{
+		input: strings.TrimPrefix(content, "\ufeff"),
+		line:  1,
+		root:  make(map[string]any),
+		kinds: make(map[string]tomlTableKind),
+	}
+	p.current = p.root
+
+	for {
+		p.skipWhitespace()
+
+		if p.eof() {
+			return p.root, nil
+		}
+
+		switch p.peek() {
+		case '#', '\n', '\r':
+			// Blank or comment-only line
+		case '[':
+			if err := p.parseTableHeader(); err != nil {
+				return nil, err
+			}
+		default:
+			if err := p.parseKeyValue(p.current, p.currentPath, p.kinds); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := p.expectLineEnd(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseTableHeader parses a [table] or [[array.of.tables]] header and makes it the current table.
+func (p *tomlParser) parseTableHeader() error {
+	p.pos++ // [
+
+	array := p.consume("[")
+
+	keys, err := p.parseKey()
+	if err != nil {
+		return err
+	}
+
+	closing := "]"
+	if array {
+		closing = "]]"
+	}
+
+	p.skipWhitespace()
+
+	if !p.consume(closing) {
+		return p.errorf("expected %q after table name", closing)
+	}
+
+	node := p.root
+	path := ""
+
+	for i, key := range keys[:len(keys)-1] {
+		path = tomlPath(path, key)
+
+		existing, ok := node[key]
+		if !ok {
+			child := make(map[string]any)
+			node[key] = child
+			p.kinds[path] = tomlImplicitTable
+			node = child
+
+			continue
+		}
+
+		name := strings.Join(keys[:i+1], ".")
+
+		switch v := existing.(type) {
+		case map[string]any:
+			if p.kinds[path] == tomlInlineTable {
+				return p.errorf("cannot extend inline table %q", name)
+			}
+
+			node = v
+		case []any:
+			if p.kinds[path] != tomlArrayOfTables {
+				return p.errorf("cannot extend array %q", name)
+			}
+
+			table, ok := v[len(v)-1].(map[string]any)
+			if !ok {
+				return p.errorf("cannot extend array %q", name)
+			}
+
+			node = table
+			path = tomlIndexPath(path, len(v)-1)
+		default:
+			return p.errorf("key %q is already defined as a value", name)
+		}
+	}
+
+	key := keys[len(keys)-1]
+	name := strings.Join(keys, ".")
+	path = tomlPath(path, key)
+	existing, exists := node[key]
+
+	if array {
+		table := make(map[string]any)
+
+		switch list, isList := existing.([]any); {
+		case !exists:
+			node[key] = []any{table}
+			p.kinds[path] = tomlArrayOfTables
+			p.currentPath = tomlIndexPath(path, 0)
+		case isList && p.kinds[path] == tomlArrayOfTables:
+			node[key] = append(list, table)
+			p.currentPath = tomlIndexPath(path, len(list))
+		default:
+			return p.errorf("key %q is already defined", name)
+		}
+
+		p.current = table
+
+		return nil
+	}
+
+	if !exists {
+		table := make(map[string]any)
+		node[key] = table
+		p.kinds[path] = tomlExplicitTable
+		p.current, p.currentPath = table, path
+
+		return nil
+	}
+
+	table, isMap := existing.(map[string]any)
+	if !isMap || p.kinds[path] != tomlImplicitTable {
+		return p.errorf("table %q is already defined", name)
+	}
+
+	p.kinds[path] = tomlExplicitTable
+	p.current, p.currentPath = table, path
+
+	return nil
+}
+
+// parseKeyValue parses a key = value pair and stores it in table, whose path in kinds is base.
+func (p *tomlParser) parseKeyValue(table map[string]any, base string, kinds map[string]tomlTableKind) error {
+	keys, err := p.parseKey()
+	if err != nil {
+		return err
+	}
+
+	p.skipWhitespace()
+
+	if !p.consume("=") {
+		return p.errorf("expected '=' after key %q", strings.Join(keys, "."))
+	}
+
+	p.skipWhitespace()
+
+	value, err := p.parseValue()
+	if err != nil {
+		return err
+	}
+
+	node := table
+	path := base
+
+	for i, key := range keys[:len(keys)-1] {
+		path = tomlPath(path, key)
+
+		existing, ok := node[key]
+		if !ok {
+			child := make(map[string]any)
+			node[key] = child
+			kinds[path] = tomlDottedTable
+			node = child
+
+			continue
+		}
+
+		child, isMap := existing.(map[string]any)
+		if kind, known := kinds[path]; !isMap || !known || kind != tomlDottedTable {
+			return p.errorf("cannot add keys to %q, it is already defined", strings.Join(keys[:i+1], "."))
+		}
+
+		node = child
+	}
+
+	key := keys[len(keys)-1]
+	if _, exists := node[key]; exists {
+		return p.errorf("duplicate key %q", strings.Join(keys, "."))
+	}
+
+	node[key] = value
+
+	if _, isMap := value.(map[string]any); isMap {
+		kinds[tomlPath(path, key)] = tomlInlineTable
+	}
+
+	return nil
+}
+
+// parseKey parses a simple or dotted key of bare and quoted parts.
+func (p *tomlParser) parseKey() ([]string, error) {
+	var keys []string
+
+	for {
+		p.skipWhitespace()
+
+		var (
+			part string
+			err  error
+		)
+
+		switch {
+		case p.consume(`"`):
+			part, err = p.parseBasicString()
+		case p.consume("'"):
+			part, err = p.parseLiteralString()
+		default:
+			start := p.pos
+			for !p.eof() && isTOMLBareKeyChar(p.peek()) {
+				p.pos++
+			}
+
+			if p.pos == start {
+				return nil, p.errorf("expected key")
+			}
+
+			part = p.input[start:p.pos]
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, part)
+
+		p.skipWhitespace()
+
+		if !p.consume(".") {
+			return keys, nil
+		}
+	}
+}
+
+// parseValue parses any TOML value starting at the current position.
+func (p *tomlParser) parseValue() (any, error) {
+	switch {
+	case p.consume(`"""`):
+		return p.parseMultilineString(`"`)
+	case p.consume(`"`):
+		return p.parseBasicString()
+	case p.consume("'''"):
+		return p.parseMultilineString("'")
+	case p.consume("'"):
+		return p.parseLiteralString()
+	case p.consume("["):
+		return p.parseArray()
+	case p.consume("{"):
+		return p.parseInlineTable()
+	}
+
+	start := p.pos
+
+	for !p.eof() && !strings.ContainsRune(" \t\r\n,]}#", rune(p.peek())) {
+		p.pos++
+
+		// A space may separate the date and time of a date-time
+		if p.pos-start == 10 && p.peek() == ' ' && tomlDateTimePattern.MatchString(p.input[start:p.pos]) &&
+			p.pos+3 < len(p.input) && isDigit(p.input[p.pos+1]) && isDigit(p.input[p.pos+2]) && p.input[p.pos+3] == ':' {
+			p.pos++
+		}
+	}
+
+	token := p.input[start:p.pos]
+	if token == "" {
+		return nil, p.errorf("expected value")
+	}
+
+	value, err := parseTOMLScalar(token)
+	if err != nil {
+		p.pos = start
+
+		return nil, p.errorf("%s", err.Error())
+	}
+
+	return value, nil
+}
+
+// parseTOMLScalar converts a bare TOML token to a boolean, number or date-time.
+func parseTOMLScalar(token string) (any, error) {
+	switch token {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "inf", "+inf":
+		return math.Inf(1), nil
+	case "-inf":
+		return math.Inf(-1), nil
+	case "nan", "+nan", "-nan":
+		return math.NaN(), nil
+	}
+
+	if tomlDecimalPattern.MatchString(token) || tomlHexPattern.MatchString(token) ||
+		tomlOctalPattern.MatchString(token) || tomlBinaryPattern.MatchString(token) {
+		parsed, err := strconv.ParseInt(strings.ReplaceAll(token, "_", ""), 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("integer %s overflows int64", token)
+		}
+
+		return parsed, nil
+	}
+
+	if tomlFloatPattern.MatchString(token) {
+		parsed, err := strconv.ParseFloat(strings.ReplaceAll(token, "_", ""), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid float %s", token)
+		}
+
+		return parsed, nil
+	}
+
+	if match := tomlDateTimePattern.FindStringSubmatch(token); match != nil {
+		return parseTOMLDateTime(match)
+	}
+
+	if tomlTimePattern.MatchString(token) {
+		if _, err := time.Parse("15:04:05.999999999", truncateTOMLFraction(token)); err != nil {
+			return nil, fmt.Errorf("invalid time %s", token)
+		}
+
+		return token, nil
+	}
+
+	return nil, fmt.Errorf("invalid value %q", token)
+}
+
+// parseTOMLDateTime converts the submatches of tomlDateTimePattern to a time.Time.
+func parseTOMLDateTime(match []string) (time.Time, error) {
+	date, clock, fraction, offset := match[1], match[2], match[3], match[4]
+
+	value := date
+	layout := time.DateOnly
+
+	if clock != "" {
+		value += "T" + clock + truncateTOMLFraction(fraction)
+		layout = "2006-01-02T15:04:05.999999999"
+
+		if offset != "" {
+			value += strings.ToUpper(offset)
+			layout = time.RFC3339Nano
+		}
+	}
+
+	parsed, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date-time %s", match[0])
+	}
+
+	return parsed, nil
+}
+
+// truncateTOMLFraction drops fractional second digits beyond nanosecond precision.
+func truncateTOMLFraction(value string) string {
+	dot := strings.IndexByte(value, '.')
+	if dot < 0 || len(value)-dot <= 10 {
+		return value
+	}
+
+	return value[:dot+10]
+}
+
+// parseArray parses array elements after the opening bracket.
+// Arrays may span lines and contain comments and a trailing comma.
+func (p *tomlParser) parseArray() ([]any, error) {
+	result := []any{}
+
+	for {
+		if err := p.skipBlankLines(); err != nil {
+			return nil, err
+		}
+
+		if p.consume("]") {
+			return result, nil
+		}
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, value)
+
+		if err := p.skipBlankLines(); err != nil {
+			return nil, err
+		}
+
+		if p.consume("]") {
+			return result, nil
+		}
+
+		if !p.consume(",") {
+			return nil, p.errorf("expected ',' or ']' in array")
+		}
+	}
+}
+
+// parseInlineTable parses an inline table after the opening brace.
+// Inline tables must fit on one line and do not allow a trailing comma.
+func (p *tomlParser) parseInlineTable() (map[string]any, error) {
+	result := make(map[string]any)
+	kinds := make(map[string]tomlTableKind)
+
+	p.skipWhitespace()
+
+	if p.consume("}") {
+		return result, nil
+	}
+
+	for {
+		if err := p.parseKeyValue(result, "", kinds); err != nil {
+			return nil, err
+		}
+
+		p.skipWhitespace()
+
+		if p.consume("}") {
+			return result, nil
+		}
+
+		if !p.consume(",") {
+			return nil, p.errorf("expected ',' or '}' in inline table")
+		}
+
+		p.skipWhitespace()
+
+		if p.peek() == '}' {
+			return nil, p.errorf("trailing comma in inline table")
+		}
+	}
+}
+
+// parseBasicString parses a double-quoted string after the opening quote.
+func (p *tomlParser) parseBasicString() (string, error) {
+	var builder strings.Builder
+
+	for {
+		if p.eof() || p.peek() == '\n' || p.peek() == '\r' {
+			return "", p.errorf("unterminated string")
+		}
+
+		ch := p.input[p.pos]
+
+		switch {
+		case ch == '"':
+			p.pos++
+
+			return builder.String(), nil
+		case ch == '\\':
+			if err := p.parseEscape(&builder); err != nil {
+				return "", err
+			}
+		case isTOMLControl(ch):
+			return "", p.errorf("control character %U in string", ch)
+		default:
+			builder.WriteByte(ch)
+			p.pos++
+		}
+	}
+}
+
+// parseLiteralString parses a single-quoted string after the opening quote.
+func (p *tomlParser) parseLiteralString() (string, error) {
+	start := p.pos
+
+	for {
+		if p.eof() || p.peek() == '\n' || p.peek() == '\r' {
+			return "", p.errorf("unterminated string")
+		}
+
+		ch := p.input[p.pos]
+		if ch == '\'' {
+			p.pos++
+
+			return p.input[start : p.pos-1], nil
+		}
+
+		if isTOMLControl(ch) {
+			return "", p.errorf("control character %U in string", ch)
+		}
+
+		p.pos++
+	}
+}
+
+// parseMultilineString parses a multi-line string after its opening delimiter.
+// quote is `"` for basic strings, which support escapes, and "'" for literal ones.
+// A newline right after the opening delimiter is trimmed.
+func (p *tomlParser) parseMultilineString(quote string) (string, error) {
+	var builder strings.Builder
+
+	if p.peek() == '\n' || strings.HasPrefix(p.input[p.pos:], "\r\n") {
+		p.skipNewline()
+	}
+
+	delimiter := strings.Repeat(quote, 3)
+
+	for {
+		if p.eof() {
+			return "", p.errorf("unterminated multi-line string")
+		}
+
+		if strings.HasPrefix(p.input[p.pos:], delimiter) {
+			// Up to two quotes may directly precede the closing delimiter
+			quotes := 0
+			for p.pos+quotes < len(p.input) && p.input[p.pos+quotes] == quote[0] {
+				quotes++
+			}
+
+			if quotes > 5 {
+				return "", p.errorf("too many quotes at the end of a multi-line string")
+			}
+
+			builder.WriteString(p.input[p.pos : p.pos+quotes-3])
+			p.pos += quotes
+
+			return builder.String(), nil
+		}
+
+		ch := p.input[p.pos]
+
+		switch {
+		case ch == '\n' || strings.HasPrefix(p.input[p.pos:], "\r\n"):
+			p.skipNewline()
+			builder.WriteByte('\n')
+		case ch == '\\' && quote == `"`:
+			if p.trimLineEndingBackslash() {
+				continue
+			}
+
+			if err := p.parseEscape(&builder); err != nil {
+				return "", err
+			}
+		case isTOMLControl(ch):
+			return "", p.errorf("control character %U in string", ch)
+		default:
+			builder.WriteByte(ch)
+			p.pos++
+		}
+	}
+}
+
+// trimLineEndingBackslash skips a backslash that ends a line together with all
+// whitespace and newlines after it, reporting whether it did.
+func (p *tomlParser) trimLineEndingBackslash() bool {
+	next := p.pos + 1
+	for next < len(p.input) && (p.input[next] == ' ' || p.input[next] == '\t') {
+		next++
+	}
+
+	if next == len(p.input) || (p.input[next] != '\n' && !strings.HasPrefix(p.input[next:], "\r\n")) {
+		return false
+	}
+
+	p.pos = next
+
+	for !p.eof() {
+		switch {
+		case p.peek() == ' ' || p.peek() == '\t':
+			p.pos++
+		case p.peek() == '\n' || strings.HasPrefix(p.input[p.pos:], "\r\n"):
+			p.skipNewline()
+		default:
+			return true
+		}
+	}
+
+	return true
+}
+
+// parseEscape decodes the escape sequence at the current backslash into builder.
+func (p *tomlParser) parseEscape(builder *strings.Builder) error {
+	if p.pos+1 >= len(p.input) {
+		return p.errorf("unterminated escape sequence")
+	}
+
+	code := p.input[p.pos+1]
+
+	simple := map[byte]byte{'b': '\b', 't': '\t', 'n': '\n', 'f': '\f', 'r': '\r', '"': '"', '\\': '\\'}
+	if replacement, ok := simple[code]; ok {
+		builder.WriteByte(replacement)
+		p.pos += 2
+
+		return nil
+	}
+
+	digits := map[byte]int{'u': 4, 'U': 8}[code]
+	if digits == 0 {
+		return p.errorf("invalid escape sequence \\%c", code)
+	}
+
+	start := p.pos + 2
+	if start+digits > len(p.input) {
+		return p.errorf("incomplete unicode escape")
+	}
+
+	point, err := strconv.ParseUint(p.input[start:start+digits], 16, 32)
+	if err != nil || !utf8.ValidRune(rune(point)) {
+		return p.errorf("invalid unicode escape \\%c%s", code, p.input[start:start+digits])
+	}
+
+	builder.WriteRune(rune(point))
+	p.pos = start + digits
+
+	return nil
+}
+
+// expectLineEnd skips trailing whitespace and a comment and consumes the end of the line.
+func (p *tomlParser) expectLineEnd() error {
+	p.skipWhitespace()
+
+	if err := p.skipComment(); err != nil {
+		return err
+	}
+
+	if p.eof() {
+		return nil
+	}
+
+	if p.peek() != '\n' && !strings.HasPrefix(p.input[p.pos:], "\r\n") {
+		return p.errorf("expected end of line, found %q", p.peek())
+	}
+
+	p.skipNewline()
+
+	return nil
+}
+
+// skipBlankLines skips whitespace, comments and newlines inside arrays.
+func (p *tomlParser) skipBlankLines() error {
+	for {
+		p.skipWhitespace()
+
+		if err := p.skipComment(); err != nil {
+			return err
+		}
+
+		if p.eof() || (p.peek() != '\n' && !strings.HasPrefix(p.input[p.pos:], "\r\n")) {
+			return nil
+		}
+
+		p.skipNewline()
+	}
+}
+
+// skipComment skips a comment up to the end of the line if one starts at the current position.
+func (p *tomlParser) skipComment() error {
+	if p.eof() || p.peek() != '#' {
+		return nil
+	}
+
+	for !p.eof() && p.peek() != '\n' && !strings.HasPrefix(p.input[p.pos:], "\r\n") {
+		if isTOMLControl(p.peek()) {
+			return p.errorf("control character %U in comment", p.peek())
+		}
+
+		p.pos++
+	}
+
+	return nil
+}
+
+// skipWhitespace skips spaces and tabs.
+func (p *tomlParser) skipWhitespace() {
+	for !p.eof() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.pos++
+	}
+}
+
+// skipNewline consumes a LF or CRLF line ending and starts a new line.
+func (p *tomlParser) skipNewline() {
+	if p.peek() == '\r' {
+		p.pos++
+	}
+
+	p.pos++
+	p.line++
+	p.lineStart = p.pos
+}
+
+// consume advances past token if the input continues with it.
+func (p *tomlParser) consume(token string) bool {
+	if !strings.HasPrefix(p.input[p.pos:], token) {
+		return false
+	}
+
+	p.pos += len(token)
+
+	return true
+}
+
+// peek returns the byte at the current position, or 0 at the end of the input.
+func (p *tomlParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+
+	return p.input[p.pos]
+}
+
+// eof reports whether the whole input has been consumed.
+func (p *tomlParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+// errorf returns an error describing a problem at the current line and column.
+func (p *tomlParser) errorf(format string, args ...any) error {
+	column := utf8.RuneCountInString(p.input[p.lineStart:p.pos]) + 1
+
+	return fmt.Errorf("line %d, column %d: %s", p.line, column, fmt.Sprintf(format, args...))
+}
+
+// tomlPath returns the kinds path of key in the table at path.
+func tomlPath(path, key string) string {
+	return path + "\x00" + key
+}
+
+// tomlIndexPath returns the kinds path of an element of the array of tables at path.
+func tomlIndexPath(path string, index int) string {
+	return path + "\x01" + strconv.Itoa(index)
+}
+
+// isTOMLBareKeyChar reports whether ch may appear in a bare key.
+func isTOMLBareKeyChar(ch byte) bool {
+	return isDigit(ch) || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch == '_' || ch == '-'
+}
+
+// isTOMLControl reports whether ch is a control character other than tab.
+func isTOMLControl(ch byte) bool {
+	return ch < 0x20 && ch != '\t' || ch == 0x7f
+}
+
+// isDigit reports whether ch is an ASCII digit.
+func isDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
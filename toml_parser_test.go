@@ -0,0 +1,371 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | toml_parser_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTOMLParser_Comprehensive tests TOML parsing with extensive coverage
+func TestTOMLParser_Comprehensive(t *testing.T) {
+	t.Run("KeysAndTables", func(t *testing.T) {
+		result, err := parseTOML(`
+# Global settings
+title = "service" # trailing comment
+"quoted key" = 1
+'literal.key' = 2
+site."google.com" = true
+
+[server]
+host = "localhost"
+port = 8080
+
+[server.tls]
+enabled = false
+
+[database . pool]
+max = 10
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"title":       "service",
+			"quoted key":  int64(1),
+			"literal.key": int64(2),
+			"site":        map[string]any{"google.com": true},
+			"server": map[string]any{
+				"host": "localhost",
+				"port": int64(8080),
+				"tls":  map[string]any{"enabled": false},
+			},
+			"database": map[string]any{"pool": map[string]any{"max": int64(10)}},
+		}, result)
+	})
+
+	t.Run("ArraysOfTables", func(t *testing.T) {
+		result, err := parseTOML(`
+[[upstreams]]
+host = "a"
+
+[upstreams.health]
+path = "/ready"
+
+[[upstreams]]
+host = "b"
+
+[[upstreams.ports]]
+number = 80
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, []any{
+			map[string]any{"host": "a", "health": map[string]any{"path": "/ready"}},
+			map[string]any{"host": "b", "ports": []any{map[string]any{"number": int64(80)}}},
+		}, result["upstreams"])
+	})
+
+	t.Run("InlineTablesAndArrays", func(t *testing.T) {
+		result, err := parseTOML(`
+point = { x = 1, y = 2, label.text = "origin" }
+empty = {}
+ports = [ 80, 443, ]
+mixed = [
+  "a", # comment
+  1.5,
+  [true, false],
+  { name = "nested" },
+]
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{"x": int64(1), "y": int64(2), "label": map[string]any{"text": "origin"}}, result["point"])
+		assert.Equal(t, map[string]any{}, result["empty"])
+		assert.Equal(t, []any{int64(80), int64(443)}, result["ports"])
+		assert.Equal(t, []any{"a", 1.5, []any{true, false}, map[string]any{"name": "nested"}}, result["mixed"])
+	})
+
+	t.Run("Strings", func(t *testing.T) {
+		result, err := parseTOML(`
+basic = "tab\tquote\" unicode\u00e9 \U0001F600"
+literal = 'C:\Users\nodejs'
+multi = """
+Roses are red
+Violets are blue"""
+folded = """\
+    The quick \
+    brown fox."""
+quotes = """Here are two quotation marks: "". Simple enough."""
+ending = """value"""""
+raw = '''
+first line
+  \n is kept'''
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, "tab\tquote\" unicodeé 😀", result["basic"])
+		assert.Equal(t, `C:\Users\nodejs`, result["literal"])
+		assert.Equal(t, "Roses are red\nViolets are blue", result["multi"])
+		assert.Equal(t, "The quick brown fox.", result["folded"])
+		assert.Equal(t, `Here are two quotation marks: "". Simple enough.`, result["quotes"])
+		assert.Equal(t, `value""`, result["ending"])
+		assert.Equal(t, "first line\n  \\n is kept", result["raw"])
+	})
+
+	t.Run("Numbers", func(t *testing.T) {
+		result, err := parseTOML(`
+decimal = +99
+negative = -17
+separated = 1_000_000
+hex = 0xDEAD_BEEF
+octal = 0o755
+binary = 0b1101
+max = 9223372036854775807
+float = 3.14
+exponent = 5e+22
+both = -2E-2
+grouped = 224_617.445_991
+infinity = -inf
+not_a_number = nan
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(99), result["decimal"])
+		assert.Equal(t, int64(-17), result["negative"])
+		assert.Equal(t, int64(1000000), result["separated"])
+		assert.Equal(t, int64(0xDEADBEEF), result["hex"])
+		assert.Equal(t, int64(0o755), result["octal"])
+		assert.Equal(t, int64(13), result["binary"])
+		assert.Equal(t, int64(math.MaxInt64), result["max"])
+		assert.Equal(t, 3.14, result["float"])
+		assert.Equal(t, 5e22, result["exponent"])
+		assert.Equal(t, -0.02, result["both"])
+		assert.Equal(t, 224617.445991, result["grouped"])
+		assert.Equal(t, math.Inf(-1), result["infinity"])
+		assert.True(t, math.IsNaN(result["not_a_number"].(float64)))
+	})
+
+	t.Run("DateTimes", func(t *testing.T) {
+		result, err := parseTOML(`
+offset = 1979-05-27T07:32:00-07:00
+utc = 1979-05-27 07:32:00.999999Z
+local = 1979-05-27T07:32:00
+date = 1979-05-27
+clock = 07:32:00
+`)
+		require.NoError(t, err)
+
+		offset := result["offset"].(time.Time)
+		assert.True(t, offset.Equal(time.Date(1979, 5, 27, 14, 32, 0, 0, time.UTC)))
+		assert.Equal(t, time.Date(1979, 5, 27, 7, 32, 0, 999999000, time.UTC), result["utc"])
+		assert.Equal(t, time.Date(1979, 5, 27, 7, 32, 0, 0, time.UTC), result["local"])
+		assert.Equal(t, time.Date(1979, 5, 27, 0, 0, 0, 0, time.UTC), result["date"])
+		assert.Equal(t, "07:32:00", result["clock"])
+	})
+
+	t.Run("CRLFAndBOM", func(t *testing.T) {
+		result, err := parseTOML("\ufeffa = 1\r\n[b]\r\nc = \"\"\"x\r\ny\"\"\"\r\n")
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(1), result["a"])
+		assert.Equal(t, map[string]any{"c": "x\ny"}, result["b"])
+	})
+}
+
+// TestTOMLParser_Errors tests rejection of invalid TOML documents
+func TestTOMLParser_Errors(t *testing.T) {
+	invalid := map[string]string{
+		"duplicate key":               "a = 1\na = 2",
+		"duplicate table":             "[a]\n[a]",
+		"table over value":            "a = 1\n[a]",
+		"table over dotted key":       "[a]\nb.c = 1\n[a.b]",
+		"dotted key into table":       "[a.b]\nc = 1\n[a]\nb.d = 2",
+		"extend inline table":         "a = { b = 1 }\n[a.c]",
+		"dotted into inline table":    "a = { b = 1 }\na.c = 2",
+		"extend static array":         "a = [1]\n[[a]]",
+		"array of tables over table":  "[a]\n[[a]]",
+		"missing value":               "a =",
+		"missing equals":              "a 1",
+		"value on next line":          "a =\n1",
+		"two pairs on one line":       "a = 1 b = 2",
+		"unterminated string":         `a = "abc`,
+		"newline in basic string":     "a = \"a\nb\"",
+		"invalid escape":              `a = "\x"`,
+		"surrogate escape":            `a = "\uD800"`,
+		"unterminated multi-line":     `a = """abc`,
+		"leading zero":                "a = 012",
+		"signed hex":                  "a = +0xFF",
+		"double underscore":           "a = 1__0",
+		"trailing dot":                "a = 1.",
+		"leading dot":                 "a = .5",
+		"integer overflow":            "a = 9223372036854775808",
+		"invalid date":                "a = 2026-02-30",
+		"invalid time":                "a = 25:00:00",
+		"inline trailing comma":       "a = { b = 1, }",
+		"inline newline":              "a = { b = 1,\nc = 2 }",
+		"unclosed array":              "a = [1, 2",
+		"missing comma":               "a = [1 2]",
+		"unclosed header":             "[a",
+		"empty key":                   "= 1",
+		"bare word":                   "a = yes",
+		"control character":           "a = \"\x01\"",
+		"control character comment":   "# \x7f\na = 1",
+		"invalid UTF-8":               "a = \"\xff\"",
+		"too many quotes":             `a = """x""""""`,
+		"lone carriage return":        "a = 1\rb = 2",
+		"array of tables unclosed":    "[[a]",
+		"array of tables over inline": "a = {}\n[[a]]",
+	}
+
+	for name, content := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseTOML(content)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("reports line and column", func(t *testing.T) {
+		_, err := parseTOML("a = 1\n\n  b = @")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 3, column 7")
+
+		_, err = parseTOML("[server]\nport = 1\nport = 2")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 3")
+		assert.Contains(t, err.Error(), `duplicate key "port"`)
+	})
+}
+
+// TestConfig_LoadFromFile_TOML tests loading TOML files through the config API
+func TestConfig_LoadFromFile_TOML(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.toml")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+name = "api"
+timeout = "30s"
+released = 2026-10-16T12:00:00Z
+
+[server]
+host = "0.0.0.0"
+port = 8080
+features = ["auth", "metrics"]
+
+[[upstreams]]
+host = "a.internal"
+weight = 1.5
+
+[[upstreams]]
+host = "b.internal"
+weight = 2.0
+`), 0o600))
+
+	c, err := New()
+	require.NoError(t, err)
+
+	require.NoError(t, c.LoadFromFile(configPath, &LoadOptions{IgnoreEnv: true}))
+
+	assert.Equal(t, "api", c.GetString("name"))
+	assert.Equal(t, 30*time.Second, c.GetDuration("timeout"))
+	assert.Equal(t, time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), c.GetTime("released"))
+	assert.Equal(t, 8080, c.GetInt("server.port"))
+	assert.Equal(t, []string{"auth", "metrics"}, c.GetStringSlice("server.features"))
+	assert.Equal(t, "b.internal", c.GetString("upstreams[1].host"))
+	assert.Equal(t, 1.5, c.GetFloat64("upstreams[0].weight"))
+
+	var target struct {
+		Server struct {
+			Host string `config:"host"`
+			Port int    `config:"port"`
+		} `config:"server"`
+	}
+	require.NoError(t, c.Unmarshal(&target))
+	assert.Equal(t, 8080, target.Server.Port)
+
+	t.Run("environment overrides keep TOML types", func(t *testing.T) {
+		t.Setenv("released", "2027-01-02")
+
+		c, err := New()
+		require.NoError(t, err)
+
+		require.NoError(t, c.LoadFromFile(configPath, nil))
+		assert.Equal(t, time.Date(2027, 1, 2, 0, 0, 0, 0, time.UTC), c.GetAll()["released"])
+	})
+
+	t.Run("detected from extension", func(t *testing.T) {
+		assert.Equal(t, FormatTOML, detectFormat("config.toml"))
+		assert.Equal(t, FormatTOML, detectFormat("/etc/app/CONFIG.TOML"))
+	})
+
+	t.Run("explicit format", func(t *testing.T) {
+		otherPath := filepath.Join(dir, "config.conf")
+		require.NoError(t, os.WriteFile(otherPath, []byte("[server]\nport = 9090\n"), 0o600))
+
+		c, err := New()
+		require.NoError(t, err)
+
+		require.NoError(t, c.LoadFromFile(otherPath, &LoadOptions{Format: FormatTOML, IgnoreEnv: true}))
+		assert.Equal(t, int64(9090), c.GetAll()["server"].(map[string]any)["port"])
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		badPath := filepath.Join(dir, "bad.toml")
+		require.NoError(t, os.WriteFile(badPath, []byte("[server]\nport = 1\nport = 2\n"), 0o600))
+
+		c, err := New()
+		require.NoError(t, err)
+
+		err = c.LoadFromFile(badPath, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse TOML config")
+		assert.Contains(t, err.Error(), "line 3")
+	})
+}
+
+// Benchmark Tests for toml_parser.go functions
+
+func BenchmarkParseTOML(b *testing.B) {
+	content := `
+title = "service"
+
+[server]
+host = "localhost"
+port = 8080
+timeouts = { read = "5s", write = "10s" }
+
+[[upstreams]]
+host = "a.internal"
+weights = [1, 2, 3]
+
+[[upstreams]]
+host = "b.internal"
+released = 2026-10-16T12:00:00Z
+`
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := parseTOML(content); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
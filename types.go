@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | types.go
 	::  ::          ::  ::    Created  | 2025-08-19
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -18,6 +18,7 @@ package config
 
 import (
 	"errors"
+	"regexp"
 	"sync"
 )
 
@@ -25,6 +26,16 @@ import (
 type Config struct {
 	mu   sync.RWMutex
 	data map[string]any
+
+	root   *Config // Configuration a Sub view belongs to, nil for a root configuration
+	prefix string  // Key of the nested map a Sub view addresses in root
+
+	keyDelimiter    string   // Separator of nested key parts, "." if empty
+	caseInsensitive bool     // Match keys regardless of case
+	timeLayouts     []string // Layouts tried by GetTime before the built-in ones
+
+	regexpMu    sync.Mutex                // Guards regexpCache, which is filled while holding mu for reading
+	regexpCache map[string]*regexp.Regexp // Compiled patterns by key
 }
 
 // Format represents supported configuration file formats.
@@ -35,6 +46,38 @@ const (
 	FormatINI Format = iota
 	FormatJSON
 	FormatYAML
+	FormatTOML
+	FormatProperties
+	FormatDotenv
+	FormatJSONC
+	FormatHCL
+	FormatXML
+)
+
+// MergeStrategy controls how nested data is combined when merging configurations.
+// The zero value replaces slices and overwrites conflicting values.
+type MergeStrategy struct {
+	Slices    SliceMergeMode // How slices present on both sides are combined
+	Conflicts ConflictMode   // What happens when a map or slice meets a value of a different shape
+}
+
+// SliceMergeMode represents the way slices are combined during a merge.
+type SliceMergeMode int
+
+// Supported slice merge modes.
+const (
+	SliceReplace      SliceMergeMode = iota // The incoming slice replaces the existing one
+	SliceAppend                             // The incoming elements are appended to the existing slice
+	SliceAppendUnique                       // Only incoming elements not already present are appended
+)
+
+// ConflictMode represents the way type conflicts are handled during a merge.
+type ConflictMode int
+
+// Supported conflict modes.
+const (
+	ConflictOverwrite ConflictMode = iota // The incoming value replaces the existing one
+	ConflictError                         // The merge fails with ErrMergeConflict
 )
 
 // Option represents a functional option for configuration.
@@ -47,8 +90,32 @@ type LoadOptions struct {
 	RequiredKeys   []string                   // Keys that must be present after loading
 	DefaultValues  map[string]any             // Default values applied before loading file
 	ValidationFunc func(map[string]any) error // Custom validation function
+	MergeStrategy  MergeStrategy              // How LoadFromFiles combines files
+	Env            *EnvOptions                // Environment variable mapping (top-level keys verbatim if nil)
+	InjectEnv      bool                       // If true, dotenv files set unset environment variables, not keys
+	XMLAttrPrefix  string                     // Prefix of XML attribute keys (default "@")
+}
+
+// EnvOptions configures how environment variables are mapped to configuration keys.
+// With Prefix "MYAPP_" and the default delimiter, "database.host" is overridden by MYAPP_DATABASE__HOST.
+type EnvOptions struct {
+	Prefix        string     // Prefix of variable names, e.g. "MYAPP_"
+	Delimiter     string     // Replaces the dots between nested key parts (default "__")
+	KeyCase       EnvKeyCase // How key names are transformed into variable names (default upper case)
+	AllowNew      bool       // If true, prefixed variables create keys the file did not define (requires Prefix)
+	ListSeparator string     // Separator used to split values overriding lists (default ",")
 }
 
+// EnvKeyCase represents the way key names are transformed into environment variable names.
+type EnvKeyCase int
+
+// Supported environment key cases.
+const (
+	EnvKeyUpper    EnvKeyCase = iota // "database.host" is read from DATABASE__HOST
+	EnvKeyLower                      // "database.host" is read from database__host
+	EnvKeyPreserve                   // Key spelling is used as-is
+)
+
 // Custom errors.
 var (
 	ErrInvalidFormat      = errors.New("invalid configuration format")
@@ -56,4 +123,10 @@ var (
 	ErrInvalidKey         = errors.New("invalid configuration key")
 	ErrRequiredKeyMissing = errors.New("required configuration key is missing")
 	ErrConfigNil          = errors.New("configuration is nil")
+	ErrMergeConflict      = errors.New("configuration merge conflict")
+	ErrInvalidEnvValue    = errors.New("invalid environment variable value")
+	ErrKeyNotFound        = errors.New("configuration key not found")
+	ErrTypeMismatch       = errors.New("configuration value type mismatch")
+	ErrInvalidTarget      = errors.New("invalid unmarshal target")
+	ErrKeyCollision       = errors.New("configuration keys differ only by case")
 )
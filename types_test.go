@@ -7,7 +7,7 @@
 	::::::::::::::::::::::
 	::  ::::::::::::::  ::    File     | types_test.go
 	::  ::          ::  ::    Created  | 2025-08-19
-		  ::::  ::::          Modified | 2025-08-19
+		  ::::  ::::          Modified | 2026-10-16
 
 	GitHub:   https://github.com/dredfort42
 	LinkedIn: https://linkedin.com/in/novikov-da
@@ -27,6 +27,12 @@ func TestFormat_Constants(t *testing.T) {
 	assert.Equal(t, Format(0), FormatINI)
 	assert.Equal(t, Format(1), FormatJSON)
 	assert.Equal(t, Format(2), FormatYAML)
+	assert.Equal(t, Format(3), FormatTOML)
+	assert.Equal(t, Format(4), FormatProperties)
+	assert.Equal(t, Format(5), FormatDotenv)
+	assert.Equal(t, Format(6), FormatJSONC)
+	assert.Equal(t, Format(7), FormatHCL)
+	assert.Equal(t, Format(8), FormatXML)
 }
 
 // TestLoadOptions_Struct tests the LoadOptions struct
@@ -53,6 +59,12 @@ func TestErrors_Constants(t *testing.T) {
 	assert.Equal(t, "invalid configuration key", ErrInvalidKey.Error())
 	assert.Equal(t, "required configuration key is missing", ErrRequiredKeyMissing.Error())
 	assert.Equal(t, "configuration is nil", ErrConfigNil.Error())
+	assert.Equal(t, "configuration merge conflict", ErrMergeConflict.Error())
+	assert.Equal(t, "invalid environment variable value", ErrInvalidEnvValue.Error())
+	assert.Equal(t, "configuration key not found", ErrKeyNotFound.Error())
+	assert.Equal(t, "configuration value type mismatch", ErrTypeMismatch.Error())
+	assert.Equal(t, "invalid unmarshal target", ErrInvalidTarget.Error())
+	assert.Equal(t, "configuration keys differ only by case", ErrKeyCollision.Error())
 }
 
 // TestConfig_Struct tests the Config struct
@@ -0,0 +1,460 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | unmarshal.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Struct tags understood by Unmarshal.
+const (
+	tagName     = "config"   // Configuration key of a field
+	tagDefault  = "default"  // Value used when the key is missing
+	tagRequired = "required" // "true" if the key must be present
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	urlType             = reflect.TypeOf(url.URL{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Unmarshal decodes the whole configuration into the struct pointed to by target.
+// Fields are matched by their `config:"name"` tag, or case-insensitively by field name
+// when the tag is absent; `config:"-"` skips a field and untagged embedded structs are inlined.
+// Values are converted with the same rules as the getters.
+//
+// Missing keys take the value of the `default:"..."` tag, converted like a configuration
+// string. Fields tagged `required:"true"` without a default must be present; all missing
+// paths are reported together in an error wrapping ErrRequiredKeyMissing.
+// Every field that failed to convert is reported in the returned error as well.
+func (c *Config) Unmarshal(target any) error {
+	if c == nil {
+		return ErrConfigNil
+	}
+
+	c.locker().RLock()
+	defer c.locker().RUnlock()
+
+	return decodeInto("", c.dataUnsafe(), target, c.caseInsensitive)
+}
+
+// UnmarshalKey decodes the value at key into target, see Unmarshal.
+// Supports both flat keys ("key") and nested keys with dot notation ("server.tls").
+func (c *Config) UnmarshalKey(key string, target any) error {
+	if c == nil {
+		return ErrConfigNil
+	}
+
+	c.locker().RLock()
+	defer c.locker().RUnlock()
+
+	value, exists := c.lookupUnsafe(key)
+	if !exists {
+		return fmt.Errorf("%w: %q", ErrKeyNotFound, key)
+	}
+
+	return decodeInto(key, value, target, c.caseInsensitive)
+}
+
+// decodeInto decodes value into the variable target points to.
+// Tagged field keys are matched case-insensitively if foldKeys is set.
+func decodeInto(path string, value any, target any, foldKeys bool) error {
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Pointer || targetValue.IsNil() {
+		return fmt.Errorf("%w: expected non-nil pointer, got %T", ErrInvalidTarget, target)
+	}
+
+	d := &decoder{foldKeys: foldKeys}
+	d.decode(path, value, targetValue.Elem())
+
+	if len(d.missing) > 0 {
+		d.errs = append(d.errs, fmt.Errorf("%w: %s", ErrRequiredKeyMissing, strings.Join(d.missing, ", ")))
+	}
+
+	return errors.Join(d.errs...)
+}
+
+// decoder converts configuration values into Go values and collects all conversion errors.
+type decoder struct {
+	errs     []error
+	missing  []string
+	foldKeys bool // Match tagged field keys case-insensitively
+}
+
+// fail records a conversion error of value at path.
+func (d *decoder) fail(path string, value any, err error) {
+	d.errs = append(d.errs, typeMismatchError(path, value, err))
+}
+
+// decode converts value and stores it in target. Nil values leave target unchanged.
+func (d *decoder) decode(path string, value any, target reflect.Value) {
+	if value == nil {
+		return
+	}
+
+	// Allocate pointers as needed and decode into the pointed-to value
+	if target.Kind() == reflect.Pointer {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+
+		d.decode(path, value, target.Elem())
+
+		return
+	}
+
+	if d.decodeSpecial(path, value, target) {
+		return
+	}
+
+	switch target.Kind() {
+	case reflect.Interface:
+		d.decodeInterface(path, value, target)
+	case reflect.String:
+		converted, err := toScalarString(value)
+		if err != nil {
+			d.fail(path, value, err)
+
+			return
+		}
+
+		target.SetString(converted)
+	case reflect.Bool:
+		converted, err := toBool(value)
+		if err != nil {
+			d.fail(path, value, err)
+
+			return
+		}
+
+		target.SetBool(converted)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		d.decodeInt(path, value, target)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		d.decodeUint(path, value, target)
+	case reflect.Float32, reflect.Float64:
+		d.decodeFloat(path, value, target)
+	case reflect.Slice:
+		d.decodeSlice(path, value, target)
+	case reflect.Map:
+		d.decodeMap(path, value, target)
+	case reflect.Struct:
+		d.decodeStruct(path, value, target)
+	default:
+		d.fail(path, value, fmt.Errorf("unsupported field type %s", target.Type()))
+	}
+}
+
+// decodeSpecial handles values of the target type itself, durations, URLs and
+// types implementing encoding.TextUnmarshaler. Returns false if value was not handled.
+func (d *decoder) decodeSpecial(path string, value any, target reflect.Value) bool {
+	valueType := reflect.TypeOf(value)
+
+	// Scalars and structs of the exact type are stored as-is, containers are always copied
+	if valueType == target.Type() && valueType.Kind() != reflect.Map && valueType.Kind() != reflect.Slice {
+		target.Set(reflect.ValueOf(value))
+
+		return true
+	}
+
+	if target.Type() == durationType {
+		converted, err := toDuration(value)
+		if err != nil {
+			d.fail(path, value, err)
+
+			return true
+		}
+
+		target.SetInt(int64(converted))
+
+		return true
+	}
+
+	if target.Type() == urlType {
+		converted, err := toURL(value)
+		if err != nil {
+			d.fail(path, value, err)
+
+			return true
+		}
+
+		target.Set(reflect.ValueOf(*converted))
+
+		return true
+	}
+
+	str, isString := value.(string)
+	if !isString || !target.CanAddr() || !reflect.PointerTo(target.Type()).Implements(textUnmarshalerType) {
+		return false
+	}
+
+	unmarshaler, ok := target.Addr().Interface().(encoding.TextUnmarshaler)
+	if !ok {
+		return false
+	}
+
+	if err := unmarshaler.UnmarshalText([]byte(str)); err != nil {
+		d.fail(path, value, err)
+	}
+
+	return true
+}
+
+// decodeInterface stores value in an interface field if it satisfies the interface.
+func (d *decoder) decodeInterface(path string, value any, target reflect.Value) {
+	converted := reflect.ValueOf(value)
+	if !converted.Type().AssignableTo(target.Type()) {
+		d.fail(path, value, fmt.Errorf("cannot convert to %s", target.Type()))
+
+		return
+	}
+
+	target.Set(reflect.ValueOf(deepCopy(value)))
+}
+
+// decodeInt stores value in a signed integer field, reporting overflow.
+func (d *decoder) decodeInt(path string, value any, target reflect.Value) {
+	converted, err := toInt64(value)
+	if err != nil {
+		d.fail(path, value, err)
+
+		return
+	}
+
+	if target.OverflowInt(converted) {
+		d.fail(path, value, fmt.Errorf("value %d overflows %s", converted, target.Type()))
+
+		return
+	}
+
+	target.SetInt(converted)
+}
+
+// decodeUint stores value in an unsigned integer field, reporting overflow.
+func (d *decoder) decodeUint(path string, value any, target reflect.Value) {
+	converted, err := toUint64(value)
+	if err != nil {
+		d.fail(path, value, err)
+
+		return
+	}
+
+	if target.OverflowUint(converted) {
+		d.fail(path, value, fmt.Errorf("value %d overflows %s", converted, target.Type()))
+
+		return
+	}
+
+	target.SetUint(converted)
+}
+
+// decodeFloat stores value in a floating point field, reporting overflow.
+func (d *decoder) decodeFloat(path string, value any, target reflect.Value) {
+	converted, err := toFloat64(value)
+	if err != nil {
+		d.fail(path, value, err)
+
+		return
+	}
+
+	if target.OverflowFloat(converted) {
+		d.fail(path, value, fmt.Errorf("value %v overflows %s", converted, target.Type()))
+
+		return
+	}
+
+	target.SetFloat(converted)
+}
+
+// decodeSlice decodes a list, or a comma-separated string, element by element.
+// The target is left unchanged if any element fails to decode.
+func (d *decoder) decodeSlice(path string, value any, target reflect.Value) {
+	var items []any
+
+	switch v := reflect.ValueOf(value); {
+	case v.Kind() == reflect.Slice:
+		items = make([]any, v.Len())
+		for i := range items {
+			items[i] = v.Index(i).Interface()
+		}
+	case v.Kind() == reflect.String:
+		items = splitList(v.String())
+	default:
+		d.fail(path, value, fmt.Errorf("cannot convert to %s", target.Type()))
+
+		return
+	}
+
+	failed := len(d.errs)
+
+	result := reflect.MakeSlice(target.Type(), len(items), len(items))
+	for i, item := range items {
+		d.decode(fmt.Sprintf("%s[%d]", path, i), item, result.Index(i))
+	}
+
+	// A partially decoded slice is not stored
+	if len(d.errs) == failed {
+		target.Set(result)
+	}
+}
+
+// decodeMap decodes a map with string keys entry by entry, adding them to an existing map.
+// The target is left unchanged if any entry fails to decode.
+func (d *decoder) decodeMap(path string, value any, target reflect.Value) {
+	source := reflect.ValueOf(value)
+	if source.Kind() != reflect.Map || source.Type().Key().Kind() != reflect.String {
+		d.fail(path, value, fmt.Errorf("cannot convert to %s", target.Type()))
+
+		return
+	}
+
+	if target.Type().Key().Kind() != reflect.String {
+		d.fail(path, value, fmt.Errorf("unsupported map key type %s", target.Type().Key()))
+
+		return
+	}
+
+	failed := len(d.errs)
+
+	result := reflect.MakeMapWithSize(target.Type(), source.Len())
+
+	iter := source.MapRange()
+	for iter.Next() {
+		key := iter.Key().String()
+		elem := reflect.New(target.Type().Elem()).Elem()
+
+		d.decode(joinPath(path, key), iter.Value().Interface(), elem)
+		result.SetMapIndex(reflect.ValueOf(key).Convert(target.Type().Key()), elem)
+	}
+
+	// A partially decoded map is not stored
+	if len(d.errs) != failed {
+		return
+	}
+
+	if target.IsNil() {
+		target.Set(result)
+
+		return
+	}
+
+	entries := result.MapRange()
+	for entries.Next() {
+		target.SetMapIndex(entries.Key(), entries.Value())
+	}
+}
+
+// decodeStruct decodes a map into the exported fields of a struct.
+func (d *decoder) decodeStruct(path string, value any, target reflect.Value) {
+	source, ok := value.(map[string]any)
+	if !ok {
+		d.fail(path, value, fmt.Errorf("cannot convert to %s", target.Type()))
+
+		return
+	}
+
+	targetType := target.Type()
+
+	for i := range targetType.NumField() {
+		field := targetType.Field(i)
+
+		name, tagged := fieldKey(field)
+		if name == "-" {
+			continue
+		}
+
+		// Untagged embedded structs share the keys of the parent.
+		// Like encoding/json, embedded structs of unexported types are inlined too.
+		if field.Anonymous && !tagged && isInlineStruct(field) {
+			d.decode(path, value, target.Field(i))
+
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		// Untagged field names are always matched case-insensitively
+		key, exists := mapKey(source, name, !tagged || d.foldKeys)
+		if !exists || source[key] == nil {
+			d.decodeMissing(joinPath(path, name), field, target.Field(i))
+
+			continue
+		}
+
+		d.decode(joinPath(path, key), source[key], target.Field(i))
+	}
+}
+
+// decodeMissing handles a struct field whose key is missing: the default tag is applied,
+// required fields are recorded and nested structs are visited for their own defaults.
+func (d *decoder) decodeMissing(path string, field reflect.StructField, target reflect.Value) {
+	if defaultValue, ok := field.Tag.Lookup(tagDefault); ok {
+		d.decode(path, defaultValue, target)
+
+		return
+	}
+
+	if required, err := strconv.ParseBool(field.Tag.Get(tagRequired)); err == nil && required {
+		d.missing = append(d.missing, strconv.Quote(path))
+
+		return
+	}
+
+	// Optional pointer sections stay nil, embedded struct sections get their defaults
+	if target.Kind() == reflect.Struct {
+		d.decodeStruct(path, map[string]any{}, target)
+	}
+}
+
+// fieldKey returns the configuration key of a struct field and whether it came from a tag.
+func fieldKey(field reflect.StructField) (string, bool) {
+	name, _, _ := strings.Cut(field.Tag.Get(tagName), ",")
+	if name == "" {
+		return field.Name, false
+	}
+
+	return name, true
+}
+
+// isInlineStruct reports whether an embedded field can be decoded in place.
+// Pointers to structs of unexported types cannot be allocated and are skipped.
+func isInlineStruct(field reflect.StructField) bool {
+	if field.Type.Kind() == reflect.Struct {
+		return true
+	}
+
+	return field.IsExported() && field.Type.Kind() == reflect.Pointer && field.Type.Elem().Kind() == reflect.Struct
+}
+
+// joinPath appends a key to a dotted path.
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
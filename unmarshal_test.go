@@ -0,0 +1,382 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | unmarshal_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTLSConfig struct {
+	Enabled  bool   `config:"enabled"`
+	CertFile string `config:"cert_file"`
+}
+
+type testServerConfig struct {
+	Host         string            `config:"host"`
+	Port         int               `config:"port"`
+	ReadTimeout  time.Duration     `config:"read_timeout"`
+	MaxBodyBytes uint32            `config:"max_body_bytes"`
+	Load         float32           `config:"load"`
+	Features     []string          `config:"features"`
+	Ports        []int             `config:"ports"`
+	Headers      map[string]string `config:"headers"`
+	TLS          *testTLSConfig    `config:"tls"`
+	BindIP       net.IP            `config:"bind_ip"`
+	Extra        any               `config:"extra"`
+	Ignored      string            `config:"-"`
+	internal     string
+}
+
+type testCommonConfig struct {
+	Name string
+}
+
+type testAppConfig struct {
+	testCommonConfig
+
+	Debug     bool
+	LogLevel  string `config:"log_level"`
+	Server    testServerConfig
+	Upstreams []testUpstream `config:"upstreams"`
+}
+
+type testUpstream struct {
+	Host   string `config:"host"`
+	Weight int    `config:"weight"`
+}
+
+// TestUnmarshal_Comprehensive tests decoding configuration into structs
+func TestUnmarshal_Comprehensive(t *testing.T) {
+	c, err := New()
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"name":      "app",
+		"DEBUG":     "true",
+		"log_level": "info",
+		"server": map[string]any{
+			"host":           "localhost",
+			"port":           8080.0,
+			"read_timeout":   "30s",
+			"max_body_bytes": "1048576",
+			"load":           0.5,
+			"features":       "auth,api",
+			"ports":          []any{80, "443"},
+			"headers":        map[string]any{"X-Env": "prod", "X-Port": 80},
+			"tls":            map[string]any{"enabled": true, "cert_file": "/etc/cert.pem"},
+			"bind_ip":        "127.0.0.1",
+			"extra":          []any{1, "two"},
+			"Ignored":        "value",
+		},
+		"upstreams": []any{
+			map[string]any{"host": "a.local", "weight": 1},
+			map[string]any{"host": "b.local", "weight": 2},
+		},
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		var cfg testAppConfig
+		require.NoError(t, c.Unmarshal(&cfg))
+
+		assert.Equal(t, "app", cfg.Name)
+		assert.True(t, cfg.Debug)
+		assert.Equal(t, "info", cfg.LogLevel)
+		assert.Equal(t, "localhost", cfg.Server.Host)
+		assert.Equal(t, 8080, cfg.Server.Port)
+		assert.Equal(t, 30*time.Second, cfg.Server.ReadTimeout)
+		assert.Equal(t, uint32(1048576), cfg.Server.MaxBodyBytes)
+		assert.Equal(t, float32(0.5), cfg.Server.Load)
+		assert.Equal(t, []string{"auth", "api"}, cfg.Server.Features)
+		assert.Equal(t, []int{80, 443}, cfg.Server.Ports)
+		assert.Equal(t, map[string]string{"X-Env": "prod", "X-Port": "80"}, cfg.Server.Headers)
+		require.NotNil(t, cfg.Server.TLS)
+		assert.True(t, cfg.Server.TLS.Enabled)
+		assert.Equal(t, "/etc/cert.pem", cfg.Server.TLS.CertFile)
+		assert.Equal(t, "127.0.0.1", cfg.Server.BindIP.String())
+		assert.Equal(t, []any{1, "two"}, cfg.Server.Extra)
+		assert.Empty(t, cfg.Server.Ignored)
+		assert.Equal(t, []testUpstream{{"a.local", 1}, {"b.local", 2}}, cfg.Upstreams)
+	})
+
+	t.Run("UnmarshalKey", func(t *testing.T) {
+		var tls testTLSConfig
+		require.NoError(t, c.UnmarshalKey("server.tls", &tls))
+		assert.True(t, tls.Enabled)
+
+		var port int
+		require.NoError(t, c.UnmarshalKey("server.port", &port))
+		assert.Equal(t, 8080, port)
+
+		var headers map[string]any
+		require.NoError(t, c.UnmarshalKey("server.headers", &headers))
+		assert.Equal(t, "prod", headers["X-Env"])
+
+		err := c.UnmarshalKey("server.missing", &tls)
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("KeepsUnsetFields", func(t *testing.T) {
+		cfg := testServerConfig{Host: "preset", ReadTimeout: time.Minute}
+		require.NoError(t, c.UnmarshalKey("server.tls", &cfg))
+
+		assert.Equal(t, "preset", cfg.Host)
+		assert.Equal(t, time.Minute, cfg.ReadTimeout)
+	})
+
+	t.Run("ReportsAllErrors", func(t *testing.T) {
+		bad, err := New()
+		require.NoError(t, err)
+
+		bad.LoadFromMap(map[string]any{
+			"server": map[string]any{
+				"host":           "ok",
+				"port":           "80a",
+				"read_timeout":   "soon",
+				"max_body_bytes": -1,
+				"ports":          []any{1, "x"},
+				"tls":            "on",
+				"bind_ip":        "not-an-ip",
+			},
+		})
+
+		var cfg testAppConfig
+		err = bad.Unmarshal(&cfg)
+		require.ErrorIs(t, err, ErrTypeMismatch)
+
+		for _, path := range []string{
+			"server.port", "server.read_timeout", "server.max_body_bytes",
+			"server.ports[1]", "server.tls", "server.bind_ip",
+		} {
+			assert.Contains(t, err.Error(), path)
+		}
+
+		// Valid fields are still decoded
+		assert.Equal(t, "ok", cfg.Server.Host)
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		var small struct {
+			Value int8 `config:"value"`
+		}
+
+		err := decodeInto("", map[string]any{"value": 300}, &small, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "overflows int8")
+	})
+
+	t.Run("InvalidTarget", func(t *testing.T) {
+		var cfg testAppConfig
+		assert.ErrorIs(t, c.Unmarshal(cfg), ErrInvalidTarget)
+		assert.ErrorIs(t, c.Unmarshal(nil), ErrInvalidTarget)
+		assert.ErrorIs(t, c.Unmarshal((*testAppConfig)(nil)), ErrInvalidTarget)
+	})
+
+	t.Run("NilConfig", func(t *testing.T) {
+		var nilConfig *Config
+
+		var cfg testAppConfig
+		assert.ErrorIs(t, nilConfig.Unmarshal(&cfg), ErrConfigNil)
+		assert.ErrorIs(t, nilConfig.UnmarshalKey("server", &cfg), ErrConfigNil)
+	})
+}
+
+// TestUnmarshal_DefaultsAndRequired tests the default and required struct tags
+func TestUnmarshal_DefaultsAndRequired(t *testing.T) {
+	type database struct {
+		Host     string        `config:"host" required:"true"`
+		Port     int           `config:"port" default:"5432"`
+		Timeout  time.Duration `config:"timeout" default:"30s"`
+		Replicas []string      `config:"replicas" default:"r1,r2"`
+		Ports    []int         `config:"ports" default:"80, 443"`
+		Password string        `config:"password" required:"true"`
+	}
+
+	type cache struct {
+		Host string `config:"host" required:"true"`
+	}
+
+	type settings struct {
+		Name     string   `config:"name" required:"true"`
+		Debug    bool     `config:"debug" default:"true"`
+		Database database `config:"database"`
+		Cache    *cache   `config:"cache"`
+		Optional string   `config:"optional" required:"false"`
+	}
+
+	t.Run("DefaultsApplied", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{
+			"name":     "app",
+			"database": map[string]any{"host": "db", "password": "secret", "port": nil},
+		})
+
+		var cfg settings
+		require.NoError(t, c.Unmarshal(&cfg))
+
+		assert.Equal(t, "app", cfg.Name)
+		assert.True(t, cfg.Debug)
+		assert.Equal(t, 5432, cfg.Database.Port)
+		assert.Equal(t, 30*time.Second, cfg.Database.Timeout)
+		assert.Equal(t, []string{"r1", "r2"}, cfg.Database.Replicas)
+		assert.Equal(t, []int{80, 443}, cfg.Database.Ports)
+		assert.Nil(t, cfg.Cache)
+	})
+
+	t.Run("PresentValuesWin", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{
+			"name":  "app",
+			"debug": false,
+			"database": map[string]any{
+				"host": "db", "password": "secret", "port": 6543, "timeout": "1m",
+			},
+		})
+
+		var cfg settings
+		require.NoError(t, c.Unmarshal(&cfg))
+
+		assert.False(t, cfg.Debug)
+		assert.Equal(t, 6543, cfg.Database.Port)
+		assert.Equal(t, time.Minute, cfg.Database.Timeout)
+	})
+
+	t.Run("AllMissingReported", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{
+			"database": map[string]any{"port": "not-a-port"},
+			"cache":    map[string]any{},
+		})
+
+		var cfg settings
+		err = c.Unmarshal(&cfg)
+		require.ErrorIs(t, err, ErrRequiredKeyMissing)
+
+		assert.Contains(t, err.Error(), `"name"`)
+		assert.Contains(t, err.Error(), `"database.host"`)
+		assert.Contains(t, err.Error(), `"database.password"`)
+		assert.Contains(t, err.Error(), `"cache.host"`)
+		assert.NotContains(t, err.Error(), "optional")
+
+		// Conversion errors are reported alongside missing keys
+		assert.Contains(t, err.Error(), "database.port")
+	})
+
+	t.Run("MissingSectionVisited", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{"name": "app"})
+
+		var cfg settings
+		err = c.Unmarshal(&cfg)
+		require.ErrorIs(t, err, ErrRequiredKeyMissing)
+		assert.Contains(t, err.Error(), `"database.host"`)
+
+		// Defaults of the missing section are still applied
+		assert.Equal(t, 5432, cfg.Database.Port)
+	})
+
+	t.Run("SpacedLists", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{"ports": "1, 2", "bad": "1, x"})
+
+		var cfg struct {
+			Ports []int `config:"ports"`
+		}
+		require.NoError(t, c.Unmarshal(&cfg))
+		assert.Equal(t, c.GetIntSlice("ports"), cfg.Ports)
+
+		ports, err := Get[[]int](c, "ports")
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, ports)
+
+		// A slice with an element that fails to decode is not stored
+		bad := []int{7}
+		err = c.UnmarshalKey("bad", &bad)
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), "bad[1]")
+		assert.Equal(t, []int{7}, bad)
+	})
+
+	t.Run("PartialMaps", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		c.LoadFromMap(map[string]any{
+			"limits": map[string]any{"rps": 10, "burst": "x"},
+			"ports":  map[string]any{"https": 443},
+		})
+
+		// A map with an entry that fails to decode is not stored
+		limits := map[string]int{"rps": 1}
+		err = c.UnmarshalKey("limits", &limits)
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), "limits.burst")
+		assert.Equal(t, map[string]int{"rps": 1}, limits)
+
+		ports := map[string]int{"http": 80}
+		require.NoError(t, c.UnmarshalKey("ports", &ports))
+		assert.Equal(t, map[string]int{"http": 80, "https": 443}, ports)
+	})
+
+	t.Run("InvalidDefault", func(t *testing.T) {
+		var cfg struct {
+			Port int `config:"port" default:"eighty"`
+		}
+
+		err := decodeInto("", map[string]any{}, &cfg, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "port")
+	})
+}
+
+// Benchmark Tests for unmarshal.go functions
+
+func BenchmarkConfig_Unmarshal(b *testing.B) {
+	c, err := New()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	c.LoadFromMap(map[string]any{
+		"server": map[string]any{
+			"host":         "localhost",
+			"port":         8080,
+			"read_timeout": "30s",
+			"features":     []any{"auth", "api"},
+		},
+	})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var cfg testServerConfig
+		_ = c.UnmarshalKey("server", &cfg)
+	}
+}
@@ -0,0 +1,137 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | walk.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+// keyValue is a leaf path with its value.
+type keyValue struct {
+	path  string
+	value any
+}
+
+// AllKeys returns the path of every leaf value in sorted order, descending into nested maps and lists,
+// e.g. ["server.host", "server.port", "upstreams[0].host"]. Empty maps and lists are leaves.
+// Parts containing the delimiter are escaped so the paths can be passed back to the getters.
+func (c *Config) AllKeys() []string {
+	leaves := c.leaves()
+	if leaves == nil {
+		return nil
+	}
+
+	keys := make([]string, len(leaves))
+	for i, leaf := range leaves {
+		keys[i] = leaf.path
+	}
+
+	return keys
+}
+
+// Walk calls fn for every leaf value with its path, in the order of AllKeys.
+// It stops at the first error returned by fn and returns it.
+// The leaves are collected before fn is first called, so fn may read and modify the configuration.
+func (c *Config) Walk(fn func(path string, value any) error) error {
+	for _, leaf := range c.leaves() {
+		if err := fn(leaf.path, leaf.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// All returns an iterator over every leaf value with its path, in the order of AllKeys:
+//
+//	for path, value := range cfg.All() {
+//		fmt.Println(path, value)
+//	}
+//
+// Like Walk, it iterates over the leaves present when iteration starts.
+func (c *Config) All() iter.Seq2[string, any] {
+	return func(yield func(string, any) bool) {
+		for _, leaf := range c.leaves() {
+			if !yield(leaf.path, leaf.value) {
+				return
+			}
+		}
+	}
+}
+
+// leaves collects every leaf value with its path.
+func (c *Config) leaves() []keyValue {
+	if c == nil {
+		return nil
+	}
+
+	c.locker().RLock()
+	defer c.locker().RUnlock()
+
+	var leaves []keyValue
+
+	data := c.dataUnsafe()
+	delimiter := c.delimiter()
+
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		walkLeaves(data[key], escapeKeyPart(key, delimiter), delimiter, func(path string, value any) {
+			leaves = append(leaves, keyValue{path: path, value: deepCopy(value)})
+		})
+	}
+
+	return leaves
+}
+
+// walkLeaves calls visit for every leaf below value, which is located at path.
+// Map keys are visited in sorted order and list elements in index order.
+func walkLeaves(value any, path, delimiter string, visit func(path string, value any)) {
+	if nestedMap, ok := value.(map[string]any); ok && len(nestedMap) > 0 {
+		for _, key := range slices.Sorted(maps.Keys(nestedMap)) {
+			walkLeaves(nestedMap[key], joinKeyPath(path, escapeKeyPart(key, delimiter), delimiter), delimiter, visit)
+		}
+
+		return
+	}
+
+	if list := reflect.ValueOf(value); list.Kind() == reflect.Slice && list.Len() > 0 {
+		for i := range list.Len() {
+			walkLeaves(list.Index(i).Interface(), indexKeyPath(path, i), delimiter, visit)
+		}
+
+		return
+	}
+
+	visit(path, value)
+}
+
+// joinKeyPath appends an escaped key part to path.
+func joinKeyPath(path, part, delimiter string) string {
+	if path == "" {
+		return part
+	}
+
+	return path + delimiter + part
+}
+
+// indexKeyPath appends a list index to path.
+func indexKeyPath(path string, index int) string {
+	return path + "[" + strconv.Itoa(index) + "]"
+}
@@ -0,0 +1,207 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | walk_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWalkConfig creates a configuration with nested maps, lists and flat dotted keys.
+func newWalkConfig(t testing.TB, options ...Option) *Config {
+	t.Helper()
+
+	c, err := New(options...)
+	require.NoError(t, err)
+
+	c.LoadFromMap(map[string]any{
+		"server": map[string]any{
+			"host": "localhost",
+			"port": 8080,
+			"tls":  map[string]any{},
+		},
+		"upstreams": []any{
+			map[string]any{"host": "a", "weights": []int{1, 2}},
+			"b",
+		},
+		"log.level": "debug",
+		"empty":     []any{},
+		"name":      "demo",
+	})
+
+	return c
+}
+
+// TestAllKeys_Comprehensive tests listing every leaf path
+func TestAllKeys_Comprehensive(t *testing.T) {
+	t.Run("lists leaves in sorted order", func(t *testing.T) {
+		c := newWalkConfig(t)
+
+		assert.Equal(t, []string{
+			"empty",
+			`log\.level`,
+			"name",
+			"server.host",
+			"server.port",
+			"server.tls",
+			"upstreams[0].host",
+			"upstreams[0].weights[0]",
+			"upstreams[0].weights[1]",
+			"upstreams[1]",
+		}, c.AllKeys())
+	})
+
+	t.Run("paths resolve with the getters", func(t *testing.T) {
+		c := newWalkConfig(t)
+
+		for _, key := range c.AllKeys() {
+			assert.True(t, c.Has(key), key)
+		}
+
+		assert.Equal(t, "debug", c.GetString(`log\.level`))
+		assert.Equal(t, 2, c.GetInt("upstreams[0].weights[1]"))
+	})
+
+	t.Run("custom delimiter and views", func(t *testing.T) {
+		c := newWalkConfig(t, WithKeyDelimiter("/"))
+
+		assert.Contains(t, c.AllKeys(), "upstreams[0]/weights[1]")
+		assert.Contains(t, c.AllKeys(), "log.level")
+		assert.Equal(t, []string{"host", "port", "tls"}, c.Sub("server").AllKeys())
+		assert.Empty(t, c.Sub("missing").AllKeys())
+	})
+
+	t.Run("empty and nil config", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+		assert.Empty(t, c.AllKeys())
+
+		var nilConfig *Config
+		assert.Nil(t, nilConfig.AllKeys())
+	})
+}
+
+// TestWalk_Comprehensive tests Walk and the All iterator
+func TestWalk_Comprehensive(t *testing.T) {
+	t.Run("visits every leaf", func(t *testing.T) {
+		c := newWalkConfig(t)
+
+		visited := make(map[string]any)
+		require.NoError(t, c.Walk(func(path string, value any) error {
+			visited[path] = value
+
+			return nil
+		}))
+
+		assert.Len(t, visited, len(c.AllKeys()))
+		assert.Equal(t, 8080, visited["server.port"])
+		assert.Equal(t, "b", visited["upstreams[1]"])
+		assert.Equal(t, map[string]any{}, visited["server.tls"])
+	})
+
+	t.Run("stops at the first error", func(t *testing.T) {
+		c := newWalkConfig(t)
+		errStop := errors.New("stop")
+
+		var paths []string
+
+		err := c.Walk(func(path string, _ any) error {
+			paths = append(paths, path)
+			if path == "name" {
+				return errStop
+			}
+
+			return nil
+		})
+
+		require.ErrorIs(t, err, errStop)
+		assert.Equal(t, []string{"empty", `log\.level`, "name"}, paths)
+	})
+
+	t.Run("callback may modify the configuration", func(t *testing.T) {
+		c := newWalkConfig(t)
+
+		require.NoError(t, c.Walk(func(path string, value any) error {
+			if s, ok := value.(string); ok {
+				c.Set(path, s+"!")
+			}
+
+			return nil
+		}))
+
+		assert.Equal(t, "localhost!", c.GetString("server.host"))
+		assert.Equal(t, "b!", c.GetString("upstreams[1]"))
+		assert.Equal(t, "debug!", c.GetString("log.level"))
+	})
+
+	t.Run("iterator", func(t *testing.T) {
+		c := newWalkConfig(t)
+
+		var paths []string
+		for path, value := range c.All() {
+			paths = append(paths, path)
+			if path == "server.host" {
+				assert.Equal(t, "localhost", value)
+			}
+		}
+
+		assert.Equal(t, c.AllKeys(), paths)
+
+		count := 0
+		for range c.All() {
+			count++
+			if count == 2 {
+				break
+			}
+		}
+
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("nil config", func(t *testing.T) {
+		var nilConfig *Config
+
+		assert.NoError(t, nilConfig.Walk(func(string, any) error { return errors.New("unexpected") }))
+
+		for range nilConfig.All() {
+			t.Fatal("unexpected leaf")
+		}
+	})
+}
+
+// Benchmark Tests for walk.go functions
+
+func BenchmarkConfig_AllKeys(b *testing.B) {
+	c := newWalkConfig(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.AllKeys()
+	}
+}
+
+func BenchmarkConfig_Walk(b *testing.B) {
+	c := newWalkConfig(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = c.Walk(func(string, any) error { return nil })
+	}
+}
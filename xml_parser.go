@@ -0,0 +1,149 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | xml_parser.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// defaultXMLAttributePrefix is prepended to attribute names in XML files.
+const defaultXMLAttributePrefix = "@"
+
+// xmlTextKey holds the text of an XML element that also has attributes or child elements.
+const xmlTextKey = "#text"
+
+// xmlElement collects the attributes, children and text of an element being parsed.
+type xmlElement struct {
+	name   string
+	fields map[string]any
+	text   strings.Builder
+}
+
+// parseXML parses an XML document into nested maps keyed by the root element name.
+// Child elements become nested keys and repeated elements become lists. Attributes are
+// stored under their name with attributePrefix ("@" if empty) and the text of an element
+// that has attributes or children under "#text". Elements with neither are read as their
+// trimmed text. Namespace prefixes are dropped and namespace declarations are skipped.
+func parseXML(content string, attributePrefix string) (map[string]any, error) {
+	if attributePrefix == "" {
+		attributePrefix = defaultXMLAttributePrefix
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(strings.TrimPrefix(content, "\ufeff")))
+
+	var (
+		stack  []*xmlElement
+		result map[string]any
+	)
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if len(stack) == 0 && result != nil {
+				line, _ := decoder.InputPos()
+
+				return nil, fmt.Errorf("line %d: multiple root elements", line)
+			}
+
+			element := &xmlElement{name: token.Name.Local, fields: make(map[string]any)}
+
+			for _, attr := range token.Attr {
+				if attr.Name.Space == "xmlns" || attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					continue
+				}
+
+				// Also catches attributes that differ only in their namespace prefix
+				key := attributePrefix + attr.Name.Local
+				if _, exists := element.fields[key]; exists {
+					line, _ := decoder.InputPos()
+
+					return nil, fmt.Errorf("line %d: duplicate attribute %q", line, attr.Name.Local)
+				}
+
+				element.fields[key] = attr.Value
+			}
+
+			stack = append(stack, element)
+		case xml.EndElement:
+			element := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if len(stack) == 0 {
+				result = map[string]any{element.name: element.value()}
+
+				continue
+			}
+
+			addXMLChild(stack[len(stack)-1].fields, element.name, element.value())
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(token)
+			} else if strings.TrimSpace(string(token)) != "" {
+				line, _ := decoder.InputPos()
+
+				return nil, fmt.Errorf("line %d: text outside the root element", line)
+			}
+		}
+	}
+
+	if result == nil {
+		return nil, errors.New("no root element")
+	}
+
+	return result, nil
+}
+
+// value returns the trimmed text of an element without attributes or children,
+// and its fields otherwise.
+func (e *xmlElement) value() any {
+	text := strings.TrimSpace(e.text.String())
+
+	if len(e.fields) == 0 {
+		return text
+	}
+
+	if text != "" {
+		e.fields[xmlTextKey] = text
+	}
+
+	return e.fields
+}
+
+// addXMLChild stores a child element value, collecting repeated elements into a list.
+func addXMLChild(fields map[string]any, name string, value any) {
+	switch existing := fields[name].(type) {
+	case nil:
+		fields[name] = value
+	case []any:
+		fields[name] = append(existing, value)
+	default:
+		fields[name] = []any{existing, value}
+	}
+}
@@ -0,0 +1,204 @@
+/*******************************************************************
+
+		::          ::        +--------+-----------------------+
+		  ::      ::          | Author | Dmitry Novikov        |
+		::::::::::::::        | Email  | dredfort.42@gmail.com |
+	  ::::  ::::::  ::::      +--------+-----------------------+
+	::::::::::::::::::::::
+	::  ::::::::::::::  ::    File     | xml_parser_test.go
+	::  ::          ::  ::    Created  | 2026-10-16
+		  ::::  ::::          Modified | 2026-10-16
+
+	GitHub:   https://github.com/dredfort42
+	LinkedIn: https://linkedin.com/in/novikov-da
+
+*******************************************************************/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestXMLParser_Comprehensive tests XML parsing with extensive coverage
+func TestXMLParser_Comprehensive(t *testing.T) {
+	t.Run("ElementsAttributesAndLists", func(t *testing.T) {
+		result, err := parseXML(`<?xml version="1.0" encoding="UTF-8"?>
+<!-- Service configuration -->
+<server name="api">
+    <host> localhost </host>
+    <listener port="8080" tls="false"/>
+    <feature>auth</feature>
+    <feature>metrics</feature>
+    <empty/>
+    <timeout unit="s">30</timeout>
+    <script><![CDATA[a < b && c]]></script>
+</server>`, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"server": map[string]any{
+				"@name":    "api",
+				"host":     "localhost",
+				"listener": map[string]any{"@port": "8080", "@tls": "false"},
+				"feature":  []any{"auth", "metrics"},
+				"empty":    "",
+				"timeout":  map[string]any{"@unit": "s", "#text": "30"},
+				"script":   "a < b && c",
+			},
+		}, result)
+	})
+
+	t.Run("RepeatedElementsWithChildren", func(t *testing.T) {
+		result, err := parseXML(`<config>
+  <upstream id="a"><port>1</port></upstream>
+  <upstream id="b"><port>2</port></upstream>
+  <upstream id="c"><port>3</port></upstream>
+</config>`, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, []any{
+			map[string]any{"@id": "a", "port": "1"},
+			map[string]any{"@id": "b", "port": "2"},
+			map[string]any{"@id": "c", "port": "3"},
+		}, result["config"].(map[string]any)["upstream"])
+	})
+
+	t.Run("AttributePrefix", func(t *testing.T) {
+		result, err := parseXML(`<server port="80"><port>8080</port></server>`, "attr_")
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"server": map[string]any{"attr_port": "80", "port": "8080"},
+		}, result)
+	})
+
+	t.Run("Namespaces", func(t *testing.T) {
+		result, err := parseXML(`<c:config xmlns:c="urn:config" xmlns="urn:default">
+  <c:server xml:lang="en" host="localhost"/>
+</c:config>`, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"config": map[string]any{
+				"server": map[string]any{"@lang": "en", "@host": "localhost"},
+			},
+		}, result)
+	})
+
+	t.Run("EntitiesAndByteOrderMark", func(t *testing.T) {
+		result, err := parseXML("\ufeff<a>caf&#233; &amp; &lt;tea&gt;</a>", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{"a": "café & <tea>"}, result)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		invalid := map[string]string{
+			"empty":                 "",
+			"declaration only":      `<?xml version="1.0"?>`,
+			"unclosed element":      "<a><b></b>",
+			"mismatched tags":       "<a></b>",
+			"multiple roots":        "<a/><b/>",
+			"text outside root":     "<a/>text",
+			"text before root":      "text<a/>",
+			"unknown entity":        "<a>&nope;</a>",
+			"unquoted attribute":    "<a b=1/>",
+			"duplicate attribute":   `<a b="1" b="2"/>`,
+			"unsupported encoding":  `<?xml version="1.0" encoding="ISO-8859-1"?><a/>`,
+			"unterminated comment":  "<a><!-- open</a>",
+			"invalid element name":  "<1a/>",
+			"stray closing element": "</a>",
+		}
+
+		for name, content := range invalid {
+			_, err := parseXML(content, "")
+			assert.Error(t, err, name)
+		}
+
+		_, err := parseXML("<a>\n  <b>\n</a>", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 3")
+	})
+}
+
+// TestConfig_LoadFromFile_XML tests loading XML files through the config API
+func TestConfig_LoadFromFile_XML(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.xml")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`<?xml version="1.0"?>
+<server>
+    <listener port="8080" timeout="30s"/>
+    <feature>auth</feature>
+    <feature>metrics</feature>
+    <database enabled="true">
+        <host>db.local</host>
+    </database>
+</server>`), 0o600))
+
+	c, err := New()
+	require.NoError(t, err)
+
+	require.NoError(t, c.LoadFromFile(configPath, &LoadOptions{IgnoreEnv: true}))
+
+	assert.Equal(t, "8080", c.GetString("server.listener.@port"))
+	assert.Equal(t, 8080, c.GetInt("server.listener.@port"))
+	assert.Equal(t, "30s", c.GetDuration("server.listener.@timeout").String())
+	assert.Equal(t, []string{"auth", "metrics"}, c.GetStringSlice("server.feature"))
+	assert.True(t, c.GetBool("server.database.@enabled"))
+	assert.Equal(t, "db.local", c.GetString("server.database.host"))
+	assert.ElementsMatch(t, []string{"server.database.@enabled", "server.database.host"}, c.GetNestedKeys("server.database"))
+
+	t.Run("custom attribute prefix", func(t *testing.T) {
+		c, err := New()
+		require.NoError(t, err)
+
+		require.NoError(t, c.LoadFromFile(configPath, &LoadOptions{IgnoreEnv: true, XMLAttrPrefix: "-"}))
+		assert.Equal(t, 8080, c.GetInt("server.listener.-port"))
+		assert.False(t, c.Has("server.listener.@port"))
+	})
+
+	t.Run("detected from extension", func(t *testing.T) {
+		assert.Equal(t, FormatXML, detectFormat("config.xml"))
+		assert.Equal(t, FormatXML, detectFormat("/etc/app/CONFIG.XML"))
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		badPath := filepath.Join(dir, "bad.xml")
+		require.NoError(t, os.WriteFile(badPath, []byte("<server>\n  <port>80</server>"), 0o600))
+
+		err := c.LoadFromFile(badPath, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse XML config")
+	})
+}
+
+// Benchmark Tests for xml_parser.go functions
+
+func BenchmarkParseXML(b *testing.B) {
+	content := `<?xml version="1.0"?>
+<config>
+    <server host="localhost" port="8080" timeout="30s"/>
+    <database>
+        <host>db.local</host>
+        <port>5432</port>
+        <pool size="1"/><pool size="2"/><pool size="3"/>
+    </database>
+    <feature>auth</feature>
+    <feature>api</feature>
+    <feature>web</feature>
+</config>`
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := parseXML(content, ""); err != nil {
+			b.Fatal(err)
+		}
+	}
+}